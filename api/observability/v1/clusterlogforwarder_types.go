@@ -125,6 +125,8 @@ type CollectorSpec struct {
 }
 
 // PipelineSpec links a set of inputs and transformations to a set of outputs.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.defaultOutputRefs) || has(self.routes)", message="defaultOutputRefs requires routes to be defined"
 type PipelineSpec struct {
 	// Name of the pipeline
 	//
@@ -162,6 +164,52 @@ type PipelineSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Filters"
 	FilterRefs []string `json:"filterRefs,omitempty"`
+
+	// Routes send records to a subset of the pipeline outputs depending on their content.
+	//
+	// When routes are spec'd, a record is forwarded to the outputs of every route it matches instead of all outputRefs.
+	// Routes are evaluated after all filters of the pipeline are applied.
+	// The outputs of each route must be listed in outputRefs.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType:=map
+	// +listMapKey:=name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Routes"
+	Routes []RouteSpec `json:"routes,omitempty"`
+
+	// DefaultOutputRefs lists the names of outputs that receive records which match no route.
+	//
+	// When omitted, records which match no route are dropped.
+	// The outputs must be listed in outputRefs.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Default Outputs"
+	DefaultOutputRefs []string `json:"defaultOutputRefs,omitempty"`
+}
+
+// RouteSpec forwards the records that pass any of its tests to a set of outputs.
+type RouteSpec struct {
+	// Name of the route
+	//
+	// +kubebuilder:validation:Pattern:="^[a-z][a-z0-9-]*[a-z0-9]$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Name string `json:"name"`
+
+	// Tests is an array of tests applied to a log record. A record matches the route if any test passes.
+	// Each test contains a sequence of conditions, all conditions must be true for the test to pass.
+	// Conditions use the same syntax as the conditions of a drop filter.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Route Tests"
+	Tests []DropTest `json:"tests"`
+
+	// OutputRefs lists the names (`output.name`) of outputs that receive the records matching this route.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Outputs"
+	OutputRefs []string `json:"outputRefs"`
 }
 
 type LimitSpec struct {
@@ -173,8 +221,72 @@ type LimitSpec struct {
 	// +kubebuilder:validation:ExclusiveMinimum:=true
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Records Per Second",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
 	MaxRecordsPerSecond int64 `json:"maxRecordsPerSecond"`
+
+	// KeyTemplate partitions the log records by the value of the template. The limit is applied to each partition separately.
+	// When omitted, the limit is applied to all records of the output or to the records of each container of the input.
+	//
+	// The KeyTemplate can be a combination of static and dynamic values consisting of field paths followed by `||` followed by another field path or a static value.
+	//
+	// A dynamic value is encased in single curly brackets `{}` and MUST end with a static fallback value separated with `||`.
+	//
+	// Static values can only contain alphanumeric characters along with dashes, underscores, dots and forward slashes.
+	//
+	// Example:
+	//
+	//  1. {.kubernetes.namespace_name||"none"}
+	//
+	//  2. {.kubernetes.labels.team||.kubernetes.namespace_name||"none"}
+	//
+	// NOTE: KeyTemplate is only supported by the rate limit of an output
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key Template",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	KeyTemplate string `json:"keyTemplate,omitempty"`
+
+	// WindowSeconds is the duration in seconds of the time window over which the rate is enforced.
+	// A longer window allows bursts above MaxRecordsPerSecond as long as the average rate over the window is within the limit.
+	// Defaults to 1
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Window (seconds)",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	WindowSeconds *int64 `json:"windowSeconds,omitempty"`
+
+	// Action defines what happens to the records that exceed the limit.
+	//
+	// `drop` discards the excess records.
+	//
+	// `count` forwards all records and only counts the excess records in the discarded events metric of the collector.
+	//
+	// Defaults to `drop`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Action"
+	Action LimitActionType `json:"action,omitempty"`
+
+	// MetricLabel enables reporting the number of excess records for each partition of the limit.
+	// The partition of the records is reported in the `key` label of the discarded events metric as `<metricLabel>=<key>`.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:="^[a-zA-Z_][a-zA-Z0-9_]*$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Metric Label",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	MetricLabel string `json:"metricLabel,omitempty"`
 }
 
+// LimitActionType defines what happens to records that exceed a rate limit
+//
+// +kubebuilder:validation:Enum:=drop;count
+type LimitActionType string
+
+const (
+	// LimitActionDrop discards the records that exceed the limit
+	LimitActionDrop LimitActionType = "drop"
+
+	// LimitActionCount forwards the records that exceed the limit and only counts them
+	LimitActionCount LimitActionType = "count"
+)
+
 // ValueReference encodes a reference to a single field in either a ConfigMap or Secret in the same namespace.
 //
 // +kubebuilder:validation:XValidation:rule="has(self.configMapName) || has(self.secretName)", message="Either configMapName or secretName needs to be set"
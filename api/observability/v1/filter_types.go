@@ -16,17 +16,22 @@ package v1
 
 // FilterType specifies the type of filter used in a pipeline
 //
-// +kubebuilder:validation:Enum:=openshiftLabels;detectMultilineException;drop;kubeAPIAudit;parse;prune
+// +kubebuilder:validation:Enum:=openshiftLabels;detectMultilineException;drop;kubeAPIAudit;parse;prune;remap;sample;dedupe;redact;modify
 type FilterType string
 
 // Filter type constants, must match JSON tags of FilterTypeSpec fields.
 const (
+	FilterTypeDedupe          FilterType = "dedupe"
 	FilterTypeDetectMultiline FilterType = "detectMultilineException"
 	FilterTypeDrop            FilterType = "drop"
 	FilterTypeKubeAPIAudit    FilterType = "kubeAPIAudit"
+	FilterTypeModify          FilterType = "modify"
 	FilterTypeOpenshiftLabels FilterType = "openshiftLabels"
 	FilterTypeParse           FilterType = "parse"
 	FilterTypePrune           FilterType = "prune"
+	FilterTypeRedact          FilterType = "redact"
+	FilterTypeRemap           FilterType = "remap"
+	FilterTypeSample          FilterType = "sample"
 )
 
 var (
@@ -37,6 +42,11 @@ var (
 		FilterTypeKubeAPIAudit,
 		FilterTypeParse,
 		FilterTypePrune,
+		FilterTypeRemap,
+		FilterTypeSample,
+		FilterTypeDedupe,
+		FilterTypeRedact,
+		FilterTypeModify,
 	}
 )
 
@@ -55,6 +65,13 @@ type FieldPath string
 // +kubebuilder:validation:XValidation:rule="self.type != 'drop' || has(self.drop)", message="Additional type specific spec is required for the filter type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'prune' || has(self.prune)", message="Additional type specific spec is required for the filter type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'openshiftLabels' || has(self.openshiftLabels)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'remap' || has(self.remap)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'sample' || has(self.sample)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'dedupe' || has(self.dedupe)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'redact' || has(self.redact)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'modify' || has(self.modify)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="!has(self.parse) || self.type == 'parse'", message="Parse spec is only allowed for the parse filter type"
+// +kubebuilder:validation:XValidation:rule="!has(self.detectMultilineException) || self.type == 'detectMultilineException'", message="DetectMultilineException spec is only allowed for the detectMultilineException filter type"
 type FilterSpec struct {
 	// Name used to refer to the filter from a "pipeline".
 	//
@@ -68,6 +85,15 @@ type FilterSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Filter Type"
 	Type FilterType `json:"type"`
 
+	// When is an array of tests applied to a log record. The filter is applied only to records for which any test passes,
+	// other records pass through the filter unchanged. When omitted, the filter is applied to all records.
+	// Each test contains a sequence of conditions, all conditions must be true for the test to pass.
+	// Conditions use the same syntax as the conditions of a drop filter.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="When"
+	When []DropTest `json:"when,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kubernetes API Audit Filter"
 	KubeAPIAudit *KubeAPIAudit `json:"kubeAPIAudit,omitempty"`
@@ -92,6 +118,122 @@ type FilterSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Labels"
 	OpenshiftLabels map[string]string `json:"openshiftLabels,omitempty"`
+
+	// Parse defines how a field of the log record is parsed into a structured object.
+	//
+	// When omitted, the `message` of container logs is parsed as JSON into the `structured` field.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Parse Filter"
+	ParseFilterSpec *ParseFilterSpec `json:"parse,omitempty"`
+
+	// DetectMultilineException defines how multi-line exceptions and messages are reassembled into a single log record.
+	//
+	// When omitted, exceptions of all supported languages are detected using the default timeouts.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Detect Multiline Exception Filter"
+	DetectMultilineExceptionSpec *DetectMultilineExceptionSpec `json:"detectMultilineException,omitempty"`
+
+	// A remap filter modifies log records using a user-supplied Vector Remap Language (VRL) program.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Remap Filter"
+	RemapFilterSpec *RemapFilterSpec `json:"remap,omitempty"`
+
+	// A sample filter forwards a fraction of the log records and drops the others.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Sample Filter"
+	SampleFilterSpec *SampleFilterSpec `json:"sample,omitempty"`
+
+	// A dedupe filter drops log records which are duplicates of a recently forwarded record.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Dedupe Filter"
+	DedupeFilterSpec *DedupeFilterSpec `json:"dedupe,omitempty"`
+
+	// A redact filter replaces sensitive values in fields of the log records.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Redact Filter"
+	RedactFilterSpec *RedactFilterSpec `json:"redact,omitempty"`
+
+	// A modify filter adds, copies, renames, moves and coerces fields of the log records.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Modify Filter"
+	ModifyFilterSpec *ModifyFilterSpec `json:"modify,omitempty"`
+}
+
+type SampleFilterSpec struct {
+	// Rate defines the fraction of log records that are forwarded: one record out of every `rate` records is kept.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rate",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	Rate int64 `json:"rate"`
+
+	// KeyField is the path to a field whose value determines if a record is sampled.
+	// Records with the same value of the field are either all kept or all dropped.
+	// When omitted, records are sampled individually.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key Field"
+	KeyField FieldPath `json:"keyField,omitempty"`
+
+	// Exclude is an array of tests applied to a log record. The record is always kept if any test passes.
+	// Each test contains a sequence of conditions, all conditions must be true for the test to pass.
+	// Conditions use the same syntax as the conditions of a drop filter.
+	//
+	// NOTE: Records with a `.level` of error or higher are always kept.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Exclude Tests"
+	Exclude []DropTest `json:"exclude,omitempty"`
+}
+
+type DedupeFilterSpec struct {
+	// Fields is the list of fields compared to identify duplicate records.
+	// A record is a duplicate when the values of all fields match those of a cached record.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Fields"
+	Fields []FieldPath `json:"fields"`
+
+	// CacheSize is the number of recently forwarded records that are cached to detect duplicates.
+	// Defaults to 5000
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cache Size",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	CacheSize *int64 `json:"cacheSize,omitempty"`
+
+	// WindowMs is the duration in milliseconds of the time windows in which duplicates are detected.
+	// Records received in different windows are never duplicates of each other.
+	// When omitted, records are compared with the cached records regardless of when they were received.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Window (ms)",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	WindowMs *int64 `json:"windowMs,omitempty"`
+}
+
+type RemapFilterSpec struct {
+	// VRL is the Vector Remap Language program applied to each log record.
+	//
+	// The operator compiles the program with the collector and rejects programs which fail to compile
+	// (e.g. a fallible function call without `!` or `??`), reporting the line and column of the compiler error.
+	// It also rejects programs which directly assign or delete `.log_type`, `.log_source` or `.kubernetes`.
+	//
+	// NOTE: `.log_type`, `.log_source` and `.kubernetes` are required for the normalization of log records.
+	// They are restored to their original values after the program runs.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="VRL Program",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	VRL string `json:"vrl"`
 }
 
 type DropTest struct {
@@ -184,3 +326,443 @@ type PruneFilterSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Fields to be kept"
 	NotIn []FieldPath `json:"notIn,omitempty"`
 }
+
+// ParseFormat specifies the format of the field being parsed
+//
+// +kubebuilder:validation:Enum:=json;logfmt;keyValue;regex;csv;syslog;commonLog;nginxCombined
+type ParseFormat string
+
+const (
+	// ParseFormatJSON parses the field as a JSON object
+	ParseFormatJSON ParseFormat = "json"
+
+	// ParseFormatLogfmt parses the field as logfmt (e.g. `level=info msg="hello world"`)
+	ParseFormatLogfmt ParseFormat = "logfmt"
+
+	// ParseFormatKeyValue parses the field as delimited key/value pairs
+	ParseFormatKeyValue ParseFormat = "keyValue"
+
+	// ParseFormatRegex parses the field using the named capture groups of a regular expression
+	ParseFormatRegex ParseFormat = "regex"
+
+	// ParseFormatCSV parses the field as a single CSV row using a list of headers for the keys
+	ParseFormatCSV ParseFormat = "csv"
+
+	// ParseFormatSyslog parses the field as a RFC3164 or RFC5424 syslog message
+	ParseFormatSyslog ParseFormat = "syslog"
+
+	// ParseFormatCommonLog parses the field as a Common Log Format (CLF) message
+	ParseFormatCommonLog ParseFormat = "commonLog"
+
+	// ParseFormatNginxCombined parses the field as a nginx access log using the combined format
+	ParseFormatNginxCombined ParseFormat = "nginxCombined"
+)
+
+// LogSource is the value of the `log_source` field of a log record (e.g. container, node, kubeAPI)
+//
+// +kubebuilder:validation:Enum:=container;node;auditd;kubeAPI;openshiftAPI;ovn
+type LogSource string
+
+// ParseFilterSpec defines how a field of a log record is parsed
+//
+// +kubebuilder:validation:XValidation:rule="self.format != 'regex' || has(self.regex)", message="regex is required when format is regex"
+// +kubebuilder:validation:XValidation:rule="self.format != 'csv' || has(self.csv)", message="csv is required when format is csv"
+type ParseFilterSpec struct {
+	// Format of the source field.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=json
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Format"
+	Format ParseFormat `json:"format,omitempty"`
+
+	// Source is the path to the field to be parsed. Defaults to `.message`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Source Field"
+	Source FieldPath `json:"source,omitempty"`
+
+	// Target is the path to the field where the parsed object is written. Defaults to `.structured`
+	//
+	// NOTE: `.log_type` and `.log_source` cannot be a target as those fields are required
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Target Field"
+	Target FieldPath `json:"target,omitempty"`
+
+	// KeepOriginal retains the source field after it is successfully parsed.
+	// The source field is removed by default.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Keep Original Field"
+	KeepOriginal bool `json:"keepOriginal,omitempty"`
+
+	// LogSources is the list of log sources to which the filter is applied. Defaults to `container`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Sources"
+	LogSources []LogSource `json:"logSources,omitempty"`
+
+	// Regex options used when format is `regex`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Regex Options"
+	Regex *ParseRegex `json:"regex,omitempty"`
+
+	// CSV options used when format is `csv`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="CSV Options"
+	CSV *ParseCSV `json:"csv,omitempty"`
+
+	// KeyValue options used when format is `keyValue`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key/Value Options"
+	KeyValue *ParseKeyValue `json:"keyValue,omitempty"`
+}
+
+type ParseRegex struct {
+	// Pattern is a regular expression with one or more named capture groups (e.g. `^(?P<level>\w+) (?P<msg>.*)$`).
+	// Each named capture group becomes a field of the parsed object.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pattern"
+	Pattern string `json:"pattern"`
+}
+
+type ParseCSV struct {
+	// Headers are the keys assigned, in order, to the values of the parsed row
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Headers"
+	Headers []string `json:"headers"`
+
+	// Delimiter is the single character separating values. Defaults to `,`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Delimiter"
+	Delimiter string `json:"delimiter,omitempty"`
+}
+
+type ParseKeyValue struct {
+	// KeyValueDelimiter separates a key from its value. Defaults to `=`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key/Value Delimiter"
+	KeyValueDelimiter string `json:"keyValueDelimiter,omitempty"`
+
+	// FieldDelimiter separates the key/value pairs. Defaults to a single space
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Field Delimiter"
+	FieldDelimiter string `json:"fieldDelimiter,omitempty"`
+}
+
+// MultilineExceptionLanguage is a programming language of which exceptions are detected
+//
+// +kubebuilder:validation:Enum:=java;python;go;ruby;js;php;dart;csharp
+type MultilineExceptionLanguage string
+
+const (
+	MultilineExceptionLanguageJava   MultilineExceptionLanguage = "java"
+	MultilineExceptionLanguagePython MultilineExceptionLanguage = "python"
+	MultilineExceptionLanguageGo     MultilineExceptionLanguage = "go"
+	MultilineExceptionLanguageRuby   MultilineExceptionLanguage = "ruby"
+	MultilineExceptionLanguageJS     MultilineExceptionLanguage = "js"
+	MultilineExceptionLanguagePHP    MultilineExceptionLanguage = "php"
+	MultilineExceptionLanguageDart   MultilineExceptionLanguage = "dart"
+	MultilineExceptionLanguageCSharp MultilineExceptionLanguage = "csharp"
+)
+
+// DetectMultilineExceptionSpec defines the options for reassembling multi-line messages
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.patterns) || !has(self.languages)", message="only one of languages or patterns can be defined"
+type DetectMultilineExceptionSpec struct {
+	// Languages is the list of languages of which exceptions are detected.
+	// This field is optional and omission results in the detection of exceptions for all supported languages.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Languages"
+	Languages []MultilineExceptionLanguage `json:"languages,omitempty"`
+
+	// ExpireAfterMs is the maximum time in milliseconds to wait for additional lines of a message before it is flushed.
+	// Defaults to 2000
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Expire After (ms)",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	ExpireAfterMs *int64 `json:"expireAfterMs,omitempty"`
+
+	// FlushIntervalMs is the interval in milliseconds at which expired messages are flushed.
+	// Defaults to 1000
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Flush Interval (ms)",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	FlushIntervalMs *int64 `json:"flushIntervalMs,omitempty"`
+
+	// GroupBy is the list of fields that identify the stream to which a line belongs. Lines are only merged
+	// with lines of the same stream.
+	// Defaults to the namespace, pod, container and stream of container logs
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Group By Fields"
+	GroupBy []FieldPath `json:"groupBy,omitempty"`
+
+	// Patterns merges lines using user-defined regular expressions instead of detecting exceptions.
+	// It supports reassembling messages of runtimes which are not one of the supported languages.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Multiline Patterns"
+	Patterns *MultilinePatterns `json:"patterns,omitempty"`
+}
+
+// MultilinePatterns are the regular expressions evaluated against the message of each line to reassemble multi-line messages.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.startPattern) || has(self.continuationPattern) || has(self.endPattern)", message="at least one of startPattern, continuationPattern or endPattern must be defined"
+// +kubebuilder:validation:XValidation:rule="!has(self.endPattern) || !(has(self.startPattern) || has(self.continuationPattern))", message="endPattern can not be defined with startPattern or continuationPattern"
+type MultilinePatterns struct {
+	// StartPattern matches the first line of a message. A matching line starts a new message.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Start Pattern"
+	StartPattern string `json:"startPattern,omitempty"`
+
+	// ContinuationPattern matches lines that continue the previous line. A line that does not match starts a new message.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Continuation Pattern"
+	ContinuationPattern string `json:"continuationPattern,omitempty"`
+
+	// EndPattern matches the last line of a message. A matching line completes the message.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="End Pattern"
+	EndPattern string `json:"endPattern,omitempty"`
+}
+
+// RedactPattern is a built-in pattern of sensitive values
+//
+// +kubebuilder:validation:Enum:=creditCard;email;ipv4;ipv6;jwt;awsKey
+type RedactPattern string
+
+const (
+	RedactPatternCreditCard RedactPattern = "creditCard"
+	RedactPatternEmail      RedactPattern = "email"
+	RedactPatternIPv4       RedactPattern = "ipv4"
+	RedactPatternIPv6       RedactPattern = "ipv6"
+	RedactPatternJWT        RedactPattern = "jwt"
+	RedactPatternAWSKey     RedactPattern = "awsKey"
+)
+
+// RedactFilterSpec defines the values that are redacted and how they are replaced
+//
+// +kubebuilder:validation:XValidation:rule="has(self.patterns) || has(self.customPatterns)", message="at least one of patterns or customPatterns must be defined"
+type RedactFilterSpec struct {
+	// Fields is the list of fields in which values are redacted.
+	// Defaults to `.message`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Fields"
+	Fields []FieldPath `json:"fields,omitempty"`
+
+	// Patterns is the list of built-in patterns of sensitive values to redact.
+	//
+	// The `creditCard` pattern matches any sequence of 13 to 19 digits, optionally separated by spaces or dashes.
+	// The Luhn checksum of the digits is not verified so other numbers of the same length, like timestamps in
+	// milliseconds, are redacted as well.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Patterns"
+	Patterns []RedactPattern `json:"patterns,omitempty"`
+
+	// CustomPatterns is the list of regular expressions matching additional values to redact.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Custom Patterns"
+	CustomPatterns []string `json:"customPatterns,omitempty"`
+
+	// Replacement defines how redacted values are replaced.
+	// When omitted, values are replaced by a fixed mask.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Replacement"
+	Replacement *RedactReplacement `json:"replacement,omitempty"`
+}
+
+// RedactReplacementType is the strategy to replace redacted values
+//
+// +kubebuilder:validation:Enum:=mask;hash;partial
+type RedactReplacementType string
+
+const (
+	// RedactReplacementMask replaces values by a fixed mask
+	RedactReplacementMask RedactReplacementType = "mask"
+
+	// RedactReplacementHash replaces values by their salted SHA-256 hash
+	RedactReplacementHash RedactReplacementType = "hash"
+
+	// RedactReplacementPartial replaces values by a mask that reveals their last characters
+	RedactReplacementPartial RedactReplacementType = "partial"
+)
+
+// RedactReplacement defines how redacted values are replaced
+//
+// +kubebuilder:validation:XValidation:rule="self.type != 'hash' || has(self.salt)", message="salt is required for the hash replacement"
+// +kubebuilder:validation:XValidation:rule="!has(self.salt) || self.type == 'hash'", message="salt is only allowed for the hash replacement"
+// +kubebuilder:validation:XValidation:rule="!has(self.revealLength) || self.type == 'partial'", message="revealLength is only allowed for the partial replacement"
+type RedactReplacement struct {
+	// Type is the strategy to replace redacted values.
+	//
+	// `mask` replaces values by the mask.
+	//
+	// `hash` replaces values by the hex encoded SHA-256 hash of the salt and the value, prefixed by `sha256:`.
+	// Identical values have the same hash which allows correlating records without revealing the values.
+	//
+	// `partial` replaces values by the mask followed by the last characters of the value.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:default:=mask
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Replacement Type"
+	Type RedactReplacementType `json:"type"`
+
+	// Mask is the value that replaces redacted values for the `mask` and `partial` replacements.
+	// Defaults to `[REDACTED]`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Mask",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Mask string `json:"mask,omitempty"`
+
+	// Salt is the key in a secret containing the salt of the `hash` replacement.
+	// The salt must only contain alphanumeric characters and `+/=._~-` (e.g. a base64 encoded value).
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Salt"
+	Salt *SecretReference `json:"salt,omitempty"`
+
+	// RevealLength is the number of trailing characters of a value revealed by the `partial` replacement.
+	// Defaults to 4
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Reveal Length",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	RevealLength *int64 `json:"revealLength,omitempty"`
+}
+
+// ModifyFilterSpec defines the operations of a modify filter
+type ModifyFilterSpec struct {
+	// Operations is the list of operations applied to each log record in the order they are defined.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Operations"
+	Operations []ModifyOperation `json:"operations"`
+}
+
+// ModifyOperationType is the operation applied to a field
+//
+// +kubebuilder:validation:Enum:=add;copy;rename;move;coerce
+type ModifyOperationType string
+
+const (
+	// ModifyOperationAdd sets the field to a value
+	ModifyOperationAdd ModifyOperationType = "add"
+
+	// ModifyOperationCopy sets the field to the value of the source
+	ModifyOperationCopy ModifyOperationType = "copy"
+
+	// ModifyOperationRename sets the field to the value of the source and removes the source
+	ModifyOperationRename ModifyOperationType = "rename"
+
+	// ModifyOperationMove is equivalent to rename and is meant for fields that change their parent
+	ModifyOperationMove ModifyOperationType = "move"
+
+	// ModifyOperationCoerce converts the value of the field to a type
+	ModifyOperationCoerce ModifyOperationType = "coerce"
+)
+
+// ModifyCoerceType is the type to which a field is converted
+//
+// +kubebuilder:validation:Enum:=string;integer;float;boolean
+type ModifyCoerceType string
+
+const (
+	ModifyCoerceString  ModifyCoerceType = "string"
+	ModifyCoerceInteger ModifyCoerceType = "integer"
+	ModifyCoerceFloat   ModifyCoerceType = "float"
+	ModifyCoerceBoolean ModifyCoerceType = "boolean"
+)
+
+// ModifyOperation defines an operation applied to a field of the log records
+//
+// +kubebuilder:validation:XValidation:rule="self.type != 'add' || (has(self.value) != has(self.valueFrom))", message="exactly one of value or valueFrom is required for the add operation"
+// +kubebuilder:validation:XValidation:rule="(!has(self.value) && !has(self.valueFrom)) || self.type == 'add'", message="value and valueFrom are only allowed for the add operation"
+// +kubebuilder:validation:XValidation:rule="!(self.type in ['copy', 'rename', 'move']) || has(self.source)", message="source is required for the copy, rename and move operations"
+// +kubebuilder:validation:XValidation:rule="!has(self.source) || self.type in ['copy', 'rename', 'move']", message="source is only allowed for the copy, rename and move operations"
+// +kubebuilder:validation:XValidation:rule="self.type != 'coerce' || has(self.coerceTo)", message="coerceTo is required for the coerce operation"
+// +kubebuilder:validation:XValidation:rule="!has(self.coerceTo) || self.type == 'coerce'", message="coerceTo is only allowed for the coerce operation"
+type ModifyOperation struct {
+	// Type is the operation applied to the field.
+	//
+	// `add` sets the field to the value, replacing any existing value.
+	//
+	// `copy` sets the field to the value of the source when the source exists.
+	//
+	// `rename` and `move` set the field to the value of the source and remove the source when the source exists.
+	//
+	// `coerce` converts the value of the field to the type of coerceTo. Values which can not be converted are unchanged.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Operation Type"
+	Type ModifyOperationType `json:"type"`
+
+	// Field is the path to the field that is modified.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Field"
+	Field FieldPath `json:"field"`
+
+	// Source is the path to the field whose value is copied, renamed or moved.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Source"
+	Source FieldPath `json:"source,omitempty"`
+
+	// Value is the value of an added field.
+	// It can be a combination of static values and dynamic values consisting of field paths followed by `||`
+	// followed by another field path or a static value.
+	//
+	// A dynamic value is encased in single curly brackets `{}` and MUST end with a static fallback value separated with `||`.
+	//
+	// Static values can only contain alphanumeric characters along with dashes, underscores, dots and forward slashes.
+	//
+	// Example:
+	//
+	//  1. production
+	//
+	//  2. {.kubernetes.namespace_name||"none"}
+	//
+	//  3. {.kubernetes.labels.team||.kubernetes.namespace_name||"none"}-app
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Value",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Value string `json:"value,omitempty"`
+
+	// ValueFrom is the key in a ConfigMap or Secret whose content is the value of an added field.
+	//
+	// The content must only contain printable ASCII characters other than `"` and `\`.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Value From"
+	ValueFrom *ValueReference `json:"valueFrom,omitempty"`
+
+	// CoerceTo is the type to which the value of the field is converted.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Coerce To"
+	CoerceTo ModifyCoerceType `json:"coerceTo,omitempty"`
+}
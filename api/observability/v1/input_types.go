@@ -20,7 +20,7 @@ import (
 
 // InputType specifies the type of log input to create.
 //
-// +kubebuilder:validation:Enum:=audit;application;infrastructure;receiver
+// +kubebuilder:validation:Enum:=audit;application;infrastructure;receiver;events;hostFiles
 type InputType string
 
 const (
@@ -32,6 +32,10 @@ const (
 	InputTypeAudit InputType = "audit"
 	// InputTypeReceiver defines a network receiver for receiving logs from non-cluster sources.
 	InputTypeReceiver InputType = "receiver"
+	// InputTypeKubernetesEvents contains the events of the kubernetes API
+	InputTypeKubernetesEvents InputType = "events"
+	// InputTypeHostFiles contains the logs written to files of the cluster nodes by node agents.
+	InputTypeHostFiles InputType = "hostFiles"
 )
 
 var (
@@ -40,6 +44,8 @@ var (
 		InputTypeInfrastructure,
 		InputTypeAudit,
 		InputTypeReceiver,
+		InputTypeKubernetesEvents,
+		InputTypeHostFiles,
 	}
 )
 
@@ -48,6 +54,8 @@ var (
 // +kubebuilder:validation:XValidation:rule="self.type != 'infrastructure' || has(self.infrastructure)", message="Additional type specific spec is required for the input type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'audit' || has(self.audit)", message="Additional type specific spec is required for the input type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'receiver' || has(self.receiver)", message="Additional type specific spec is required for the input type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'events' || has(self.events)", message="Additional type specific spec is required for the input type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'hostFiles' || has(self.hostFiles)", message="Additional type specific spec is required for the input type"
 type InputSpec struct {
 	// Name used to refer to the input of a `pipeline`.
 	//
@@ -86,8 +94,23 @@ type InputSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Receiver"
 	Receiver *ReceiverSpec `json:"receiver,omitempty"`
+
+	// Events, enables the collection of kubernetes events.
+	//
+	// Events are collected by a single collector instance and require the collector to be deployed as a deployment.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kubernetes Events Input"
+	Events *KubernetesEvents `json:"events,omitempty"`
+
+	// HostFiles, enables the collection of `infrastructure` logs written to files of the cluster nodes.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Host Files Input"
+	HostFiles *HostFiles `json:"hostFiles,omitempty"`
 }
 
+// +kubebuilder:validation:XValidation:rule="!has(self.rateLimitPerContainer) || !has(self.rateLimitPerContainer.keyTemplate)", message="keyTemplate is not supported by the per-container rate limit"
 type ContainerInputTuningSpec struct {
 
 	// RateLimitPerContainer is the limit applied to each container
@@ -123,6 +146,41 @@ type Application struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pod Selector",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:selector:core:v1:Pod"}
 	Selector *metav1.LabelSelector `json:"selector,omitempty"`
 
+	// NamespaceSelector for logs from pods in namespaces with matching labels.
+	//
+	// Only messages from pods in namespaces with these labels are collected.
+	//
+	// If absent or empty, logs are collected regardless of the labels of the namespace.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespace Selector",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:selector:core:v1:Namespace"}
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Streams is the list of container streams from which to collect logs.
+	//
+	// If absent or empty, logs are collected from all streams.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems:=2
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Streams"
+	Streams []ContainerStream `json:"streams,omitempty"`
+
+	// AnnotationPolicy defines how pod annotations are honored when selecting container logs.
+	//
+	// Ignore: pod annotations are not honored (default)
+	//
+	// OptOut: logs are collected unless the pod is annotated with 'observability.openshift.io/collect: "false"'
+	//
+	// OptIn: logs are only collected when the pod is annotated with 'observability.openshift.io/collect: "true"'
+	//
+	// For OptOut and OptIn, individual containers of a pod are dropped when listed in the
+	// comma-separated pod annotation 'observability.openshift.io/exclude-containers'.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=Ignore
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Annotation Policy"
+	AnnotationPolicy PodAnnotationPolicy `json:"annotationPolicy,omitempty"`
+
 	// Tuning is the container input tuning spec for this container sources
 	//
 	// +kubebuilder:validation:Optional
@@ -146,6 +204,32 @@ type Application struct {
 	Excludes []NamespaceContainerSpec `json:"excludes,omitempty"`
 }
 
+// ContainerStream is the standard stream of a container to which a log is written.
+//
+// +kubebuilder:validation:Enum:=stdout;stderr
+type ContainerStream string
+
+const (
+	ContainerStreamStdout ContainerStream = "stdout"
+	ContainerStreamStderr ContainerStream = "stderr"
+)
+
+// PodAnnotationPolicy defines how pod annotations are honored when selecting container logs.
+//
+// +kubebuilder:validation:Enum:=Ignore;OptOut;OptIn
+type PodAnnotationPolicy string
+
+const (
+	// PodAnnotationPolicyIgnore does not honor pod annotations
+	PodAnnotationPolicyIgnore PodAnnotationPolicy = "Ignore"
+
+	// PodAnnotationPolicyOptOut collects logs unless a pod or container opts out by annotation
+	PodAnnotationPolicyOptOut PodAnnotationPolicy = "OptOut"
+
+	// PodAnnotationPolicyOptIn only collects logs from pods that opt in by annotation
+	PodAnnotationPolicyOptIn PodAnnotationPolicy = "OptIn"
+)
+
 type NamespaceContainerSpec struct {
 
 	// Namespace specs the namespace from which to collect logs
@@ -188,6 +272,8 @@ var (
 // Sources of these logs:
 // * container workloads deployed to namespaces: default, kube*, openshift*
 // * journald logs from cluster nodes
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.journal) || !has(self.sources) || self.sources.size() == 0 || 'node' in self.sources", message="journal is only supported when collecting the node source"
 type Infrastructure struct {
 	// Sources defines the list of infrastructure sources to collect.
 	// This field is optional and omission results in the collection of all infrastructure sources.
@@ -195,6 +281,91 @@ type Infrastructure struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Sources"
 	Sources []InfrastructureSource `json:"sources,omitempty"`
+
+	// Journal filters the journald logs collected from the cluster nodes.
+	// This field is optional and its exclusion results in the collection of all journald logs of the current boot.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Journal Filters"
+	Journal *Journal `json:"journal,omitempty"`
+}
+
+// SystemdUnit is the name of a systemd unit (e.g. kubelet.service).
+// A name without a unit type suffix is the name of a service.
+//
+// +kubebuilder:validation:Pattern:=`^[a-zA-Z0-9:_.@-]+$`
+// +kubebuilder:validation:MaxLength:=256
+type SystemdUnit string
+
+// JournalPriority is the syslog priority of a journal entry.
+//
+// +kubebuilder:validation:Enum:=emerg;alert;crit;err;warning;notice;info;debug
+type JournalPriority string
+
+const (
+	JournalPriorityEmergency JournalPriority = "emerg"
+	JournalPriorityAlert     JournalPriority = "alert"
+	JournalPriorityCritical  JournalPriority = "crit"
+	JournalPriorityError     JournalPriority = "err"
+	JournalPriorityWarning   JournalPriority = "warning"
+	JournalPriorityNotice    JournalPriority = "notice"
+	JournalPriorityInfo      JournalPriority = "info"
+	JournalPriorityDebug     JournalPriority = "debug"
+)
+
+var (
+	// JournalPriorities are the journal priorities ordered by their numeric value
+	JournalPriorities = []JournalPriority{
+		JournalPriorityEmergency,
+		JournalPriorityAlert,
+		JournalPriorityCritical,
+		JournalPriorityError,
+		JournalPriorityWarning,
+		JournalPriorityNotice,
+		JournalPriorityInfo,
+		JournalPriorityDebug,
+	}
+)
+
+// Journal filters the entries collected from the journal of the cluster nodes.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.minimumPriority) || !has(self.matches) || !('PRIORITY' in self.matches)", message="PRIORITY matches are not supported with minimumPriority"
+type Journal struct {
+	// IncludeUnits is the list of systemd units whose entries are collected.
+	// This field is optional and its exclusion results in the collection of the entries of all units.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Include Units"
+	IncludeUnits []SystemdUnit `json:"includeUnits,omitempty"`
+
+	// ExcludeUnits is the list of systemd units whose entries are not collected.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Exclude Units"
+	ExcludeUnits []SystemdUnit `json:"excludeUnits,omitempty"`
+
+	// MinimumPriority is the least severe priority of the entries which are collected (e.g. warning collects
+	// the entries of priority emerg, alert, crit, err and warning).
+	// This field is optional and its exclusion results in the collection of entries of all priorities
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Minimum Priority"
+	MinimumPriority JournalPriority `json:"minimumPriority,omitempty"`
+
+	// CurrentBootOnly collects only the entries of the current boot of a node when true.
+	// This field is optional and defaults to true.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Current Boot Only",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	CurrentBootOnly *bool `json:"currentBootOnly,omitempty"`
+
+	// Matches is a map of journal field names (e.g. _TRANSPORT) to the values of the entries which are collected.
+	// Entries must match a value of every field and the field names must be uppercase.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self.all(k, k.matches('^[A-Z0-9_]+$'))", message="Journal field names must be uppercase letters, digits and underscores"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Matches"
+	Matches map[string][]string `json:"matches,omitempty"`
 }
 
 // AuditSource defines which type of audit log source is used.
@@ -235,26 +406,123 @@ type Audit struct {
 	Sources []AuditSource `json:"sources,omitempty"`
 }
 
+// KubernetesEventType is the type of a kubernetes event.
+//
+// +kubebuilder:validation:Enum:=Normal;Warning
+type KubernetesEventType string
+
+const (
+	KubernetesEventTypeNormal  KubernetesEventType = "Normal"
+	KubernetesEventTypeWarning KubernetesEventType = "Warning"
+)
+
+// KubernetesEvents enables the collection of kubernetes events.
+//
+// Events of the namespaces: default, kube*, openshift* are forwarded with a log_type of `infrastructure`.
+// All other events are forwarded with a log_type of `application`.
+//
+// Events are listed from the API server cache at each poll interval, one request per listed namespace.
+// An event is forwarded when it was created or updated since the previous poll. Events updated
+// while the collector is not running, for longer than a poll interval, are not forwarded.
+type KubernetesEvents struct {
+	// Namespaces is the list of namespaces of the events to collect.
+	// This field is optional and its exclusion results in the collection of the events of all namespaces.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self.all(ns, ns.matches('^[a-z0-9]([-a-z0-9]*[a-z0-9])?$'))",message="namespaces must be valid namespace names"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespaces"
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// InvolvedObjectKinds is the list of kinds of the objects involved in the events to collect (e.g. Pod, Node).
+	// This field is optional and its exclusion results in the collection of the events of all kinds.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Involved Object Kinds"
+	InvolvedObjectKinds []string `json:"involvedObjectKinds,omitempty"`
+
+	// Types is the list of types of the events to collect.
+	// This field is optional and its exclusion results in the collection of events of all types.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Event Types"
+	Types []KubernetesEventType `json:"types,omitempty"`
+
+	// PollIntervalSeconds is the interval, in seconds, between two listings of the events.
+	// This field is optional and defaults to 15 seconds.
+	//
+	// +kubebuilder:validation:Minimum:=5
+	// +kubebuilder:validation:Maximum:=300
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Poll Interval Seconds"
+	PollIntervalSeconds *int64 `json:"pollIntervalSeconds,omitempty"`
+}
+
+// HostFiles enables the collection of the log files written to the cluster nodes by node agents (e.g. GPU drivers).
+// Files are collected from the directories under /var/log which are not collected by other sources and are
+// forwarded with a log_type of `infrastructure`.
+type HostFiles struct {
+	// Includes is the list of glob patterns of the absolute paths of the files to collect (e.g. /var/log/nvidia/*.log).
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +kubebuilder:validation:XValidation:rule="self.all(p, p.startsWith('/'))", message="Paths must be absolute"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Include Paths"
+	Includes []string `json:"includes"`
+
+	// Excludes is the list of glob patterns of the absolute paths of the included files which are not collected.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self.all(p, p.startsWith('/'))", message="Paths must be absolute"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Exclude Paths"
+	Excludes []string `json:"excludes,omitempty"`
+
+	// MultilineStartPattern is a regular expression matching the first line of a multiline record.
+	// Lines are appended to a record until a line matching the pattern starts the next record.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Multiline Start Pattern"
+	MultilineStartPattern string `json:"multilineStartPattern,omitempty"`
+
+	// ReadFromBeginning reads a file from its beginning when it is first discovered instead of only collecting the
+	// lines written after it was discovered.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Read From Beginning",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	ReadFromBeginning bool `json:"readFromBeginning,omitempty"`
+}
+
 // ReceiverType specifies the type of receiver that should be created.
 //
-// +kubebuilder:validation:Enum:=http;syslog
+// +kubebuilder:validation:Enum:=http;syslog;otlp;forward
 type ReceiverType string
 
 const (
-	ReceiverTypeHTTP   ReceiverType = "http"
-	ReceiverTypeSyslog ReceiverType = "syslog"
+	ReceiverTypeHTTP    ReceiverType = "http"
+	ReceiverTypeSyslog  ReceiverType = "syslog"
+	ReceiverTypeOTLP    ReceiverType = "otlp"
+	ReceiverTypeForward ReceiverType = "forward"
 )
 
 var (
 	ReceiverTypes = []ReceiverType{
 		ReceiverTypeHTTP,
 		ReceiverTypeSyslog,
+		ReceiverTypeOTLP,
+		ReceiverTypeForward,
 	}
 )
 
 type InputTLSSpec TLSSpec
 
 // ReceiverSpec is a union of input Receiver types.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.syslog) || self.type == 'syslog'", message="Syslog spec is only allowed for the syslog receiver type"
+// +kubebuilder:validation:XValidation:rule="!has(self.otlp) || self.type == 'otlp'", message="OTLP spec is only allowed for the otlp receiver type"
+// +kubebuilder:validation:XValidation:rule="!has(self.otlp) || !has(self.otlp.grpcPort) || self.otlp.grpcPort != self.port", message="grpcPort must be different from port"
+// +kubebuilder:validation:XValidation:rule="!has(self.forward) || self.type == 'forward'", message="Forward spec is only allowed for the forward receiver type"
+// +kubebuilder:validation:XValidation:rule="!has(self.authentication) || (!has(self.authentication.token) && !has(self.authentication.username)) || self.type == 'http'", message="Token and basic authentication are only supported for the http receiver type"
+// +kubebuilder:validation:XValidation:rule="!has(self.authentication) || !has(self.authentication.clientCA) || !has(self.syslog) || !has(self.syslog.protocol) || self.syslog.protocol == 'tcp'", message="Client certificates are only supported for the tcp protocol of the syslog receiver"
+// +kubebuilder:validation:XValidation:rule="!has(self.tls) || !has(self.syslog) || !has(self.syslog.protocol) || self.syslog.protocol == 'tcp'", message="TLS is only supported for the tcp protocol of the syslog receiver"
 type ReceiverSpec struct {
 	// Type of Receiver plugin.
 	//
@@ -272,6 +540,13 @@ type ReceiverSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TLS Options"
 	TLS *InputTLSSpec `json:"tls,omitempty"`
 
+	// Authentication of the clients which send logs to the receiver. Receivers accept logs from any client which can
+	// reach the service when it is not spec'd
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authentication"
+	Authentication *ReceiverAuthentication `json:"authentication,omitempty"`
+
 	// Port the Receiver listens on. It must be a value between 1024 and 65535
 	//
 	// +kubebuilder:validation:Minimum:=1024
@@ -282,22 +557,211 @@ type ReceiverSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="HTTP Receiver Configuration"
 	HTTP *HTTPReceiver `json:"http,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Syslog Receiver Configuration"
+	Syslog *SyslogReceiver `json:"syslog,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="OTLP Receiver Configuration"
+	OTLP *OTLPReceiver `json:"otlp,omitempty"`
+
+	// Forward configures the receiver of the Forward protocol.
+	//
+	// The `shared_key` handshake of secure-forward is not supported. Use `authentication.clientCA` to
+	// authenticate Fluentd and Fluent Bit clients with their TLS certificates instead.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Forward Receiver Configuration"
+	Forward *ForwardReceiver `json:"forward,omitempty"`
+}
+
+// ReceiverAuthentication contains configuration for authenticating the clients of a receiver.
+//
+// Requests of the `http` receiver which are rejected with status 401 are counted by the collector metric
+// `vector_http_server_responses_sent_total{component_id="input_<input name>",status="401"}`, where spaces, `-` and `.`
+// in the lowercased input name are replaced by `_`.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.token) || (!has(self.username) && !has(self.password))", message="Token and basic authentication are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="has(self.username) == has(self.password)", message="Username and password must be spec'd together"
+type ReceiverAuthentication struct {
+	// ClientCA is the CA bundle used to verify the certificates of clients.
+	// Connections from clients which do not present a certificate signed by it are rejected during the TLS handshake.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Client CA"
+	ClientCA *ValueReference `json:"clientCA,omitempty"`
+
+	// Token is the bearer token which clients must present in the `Authorization` header of their requests.
+	// Requests without it are rejected with status 401.
+	//
+	// The token must only contain the characters of a RFC 6750 bearer token: alphanumerics and `-._~+/`
+	// optionally followed by `=` padding.
+	//
+	// Only supported for the `http` receiver type
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Bearer Token"
+	Token *SecretReference `json:"token,omitempty"`
+
+	// Username which clients must present using basic authentication.
+	// Requests without valid credentials are rejected with status 401.
+	//
+	// Only supported for the `http` receiver type
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Username"
+	Username *SecretReference `json:"username,omitempty"`
+
+	// Password which clients must present using basic authentication.
+	//
+	// Only supported for the `http` receiver type
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Password"
+	Password *SecretReference `json:"password,omitempty"`
 }
 
 // HTTPReceiverFormat defines the type of log data incoming through the HTTP receiver.
 //
-// +kubebuilder:validation:Enum:=kubeAPIAudit
+// +kubebuilder:validation:Enum:=kubeAPIAudit;json;ndjson;text
 type HTTPReceiverFormat string
 
 const (
+	// HTTPReceiverFormatKubeAPIAudit receives audit events from a kubernetes API server webhook backend
 	HTTPReceiverFormatKubeAPIAudit HTTPReceiverFormat = "kubeAPIAudit"
+
+	// HTTPReceiverFormatJSON receives a JSON object or an array of JSON objects per request
+	HTTPReceiverFormatJSON HTTPReceiverFormat = "json"
+
+	// HTTPReceiverFormatNDJSON receives newline delimited JSON objects
+	HTTPReceiverFormatNDJSON HTTPReceiverFormat = "ndjson"
+
+	// HTTPReceiverFormatText receives newline delimited lines of plain text which are stored in the `message` field
+	HTTPReceiverFormatText HTTPReceiverFormat = "text"
 )
 
 // HTTPReceiver receives encoded logs as a HTTP endpoint.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.itemsPath) || self.format == 'json'", message="itemsPath is only supported for the json format"
+// +kubebuilder:validation:XValidation:rule="self.format != 'kubeAPIAudit' || (!has(self.logType) && !has(self.logSource))", message="logType and logSource are not supported for the kubeAPIAudit format"
 type HTTPReceiver struct {
 	// Format is the format of incoming log data.
 	//
 	// +kubebuilder:validation:Required
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Data Format"
 	Format HTTPReceiverFormat `json:"format"`
+
+	// ItemsPath is the path to an array field of a JSON payload whose elements are received as individual records
+	// (e.g. `.records`). A payload which is a JSON array is always received as a record per element.
+	//
+	// Only supported for the `json` format
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(\.[a-zA-Z0-9_]+|\."[^"]+")+$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Items Path",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ItemsPath string `json:"itemsPath,omitempty"`
+
+	// LogType is the log type of received records. Defaults to `application`
+	//
+	// Not supported for the `kubeAPIAudit` format which receives audit logs
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum:=application;infrastructure
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Type"
+	LogType InputType `json:"logType,omitempty"`
+
+	// LogSource is the log source of received records. Defaults to `http`
+	//
+	// Not supported for the `kubeAPIAudit` format which receives audit logs
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^[a-zA-Z0-9_-]+$`
+	// +kubebuilder:validation:MaxLength:=63
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Source",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	LogSource string `json:"logSource,omitempty"`
+}
+
+// SyslogReceiverProtocol is the transport protocol on which the syslog receiver listens.
+//
+// +kubebuilder:validation:Enum:=tcp;udp;both
+type SyslogReceiverProtocol string
+
+const (
+	SyslogReceiverProtocolTCP  SyslogReceiverProtocol = "tcp"
+	SyslogReceiverProtocolUDP  SyslogReceiverProtocol = "udp"
+	SyslogReceiverProtocolBoth SyslogReceiverProtocol = "both"
+)
+
+// SyslogReceiver receives RFC3164 and RFC5424 formatted messages.
+type SyslogReceiver struct {
+	// Protocol is the transport protocol on which the receiver listens.
+	// `both` listens for TCP and UDP on the same port.
+	//
+	// TLS is only supported for the `tcp` protocol. The operator does not request certificates for
+	// receivers which listen for UDP.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=tcp
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Protocol"
+	Protocol SyslogReceiverProtocol `json:"protocol,omitempty"`
+
+	// MaxMessageLength is the maximum length in bytes of a message. Longer messages are discarded.
+	// Defaults to 102400
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Maximum Message Length",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	MaxMessageLength *int64 `json:"maxMessageLength,omitempty"`
+
+	// IncludePeerAddress records the IP address of the client which sent a message in the `peer_address` field of the log record.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Include Peer Address",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	IncludePeerAddress bool `json:"includePeerAddress,omitempty"`
+}
+
+// OTLPReceiver receives logs from OpenTelemetry log exporters using the OpenTelemetry Protocol.
+// OTLP/HTTP is received on the port of the receiver.
+type OTLPReceiver struct {
+	// GRPCPort enables OTLP/gRPC on the given port in addition to OTLP/HTTP.
+	// It must be a value between 1024 and 65535 that is different from the port of the receiver
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1024
+	// +kubebuilder:validation:Maximum:=65535
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="gRPC Port",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	GRPCPort *int32 `json:"grpcPort,omitempty"`
+}
+
+// ForwardReceiver receives logs from Fluentd and Fluent Bit using the Forward protocol.
+type ForwardReceiver struct {
+	// TagNamespaces maps the tags of forwarded records to namespaces which are recorded in the `kubernetes.namespace_name`
+	// field of the log record. The first mapping whose tag matches a record is applied
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tag Namespaces"
+	TagNamespaces []ForwardTagNamespace `json:"tagNamespaces,omitempty"`
+}
+
+// ForwardTagNamespace maps the tags of forwarded records to a namespace
+type ForwardTagNamespace struct {
+	// Tag is a regular expression matched against the tag of a forwarded record (e.g. `^payments\.`)
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tag",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Tag string `json:"tag"`
+
+	// Namespace of the records whose tag matches
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength:=63
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespace",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Namespace string `json:"namespace"`
 }
@@ -23,11 +23,12 @@ import (
 
 // OutputType is used to define the type of output to be created.
 //
-// +kubebuilder:validation:Enum:=azureMonitor;cloudwatch;elasticsearch;http;kafka;loki;lokiStack;googleCloudLogging;splunk;syslog;otlp
+// +kubebuilder:validation:Enum:=azureLogsIngestion;azureMonitor;cloudwatch;elasticsearch;http;kafka;loki;lokiStack;googleCloudLogging;s3;splunk;syslog;otlp
 type OutputType string
 
 // Output type constants, must match JSON tags of OutputTypeSpec fields.
 const (
+	OutputTypeAzureLogsIngestion OutputType = "azureLogsIngestion"
 	OutputTypeAzureMonitor       OutputType = "azureMonitor"
 	OutputTypeCloudwatch         OutputType = "cloudwatch"
 	OutputTypeElasticsearch      OutputType = "elasticsearch"
@@ -37,6 +38,7 @@ const (
 	OutputTypeLoki               OutputType = "loki"
 	OutputTypeLokiStack          OutputType = "lokiStack"
 	OutputTypeOTLP               OutputType = "otlp"
+	OutputTypeS3                 OutputType = "s3"
 	OutputTypeSplunk             OutputType = "splunk"
 	OutputTypeSyslog             OutputType = "syslog"
 )
@@ -44,6 +46,7 @@ const (
 var (
 	// OutputTypes contains all supported output types.
 	OutputTypes = []OutputType{
+		OutputTypeAzureLogsIngestion,
 		OutputTypeAzureMonitor,
 		OutputTypeCloudwatch,
 		OutputTypeElasticsearch,
@@ -52,6 +55,7 @@ var (
 		OutputTypeKafka,
 		OutputTypeLoki,
 		OutputTypeLokiStack,
+		OutputTypeS3,
 		OutputTypeSplunk,
 		OutputTypeSyslog,
 		OutputTypeOTLP,
@@ -60,6 +64,7 @@ var (
 
 // OutputSpec defines a destination for log messages.
 //
+// +kubebuilder:validation:XValidation:rule="self.type != 'azureLogsIngestion' || has(self.azureLogsIngestion)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'azureMonitor' || has(self.azureMonitor)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'cloudwatch' || has(self.cloudwatch)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'elasticsearch' || has(self.elasticsearch)", message="Additional type specific spec is required for the output type"
@@ -68,6 +73,7 @@ var (
 // +kubebuilder:validation:XValidation:rule="self.type != 'kafka' || has(self.kafka)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'loki' || has(self.loki)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'lokiStack' || has(self.lokiStack)", message="Additional type specific spec is required for the output type"
+// +kubebuilder:validation:XValidation:rule="self.type != 's3' || has(self.s3)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'splunk' || has(self.splunk)", message="Additional type specific spec is required the for output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'syslog' || has(self.syslog)", message="Additional type specific spec is required the for output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'otlp' || has(self.otlp)", message="Additional type specific spec is required the for output type"
@@ -99,6 +105,10 @@ type OutputSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rate Limiting"
 	Limit *LimitSpec `json:"rateLimit,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Azure Logs Ingestion"
+	AzureLogsIngestion *AzureLogsIngestion `json:"azureLogsIngestion,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Azure Monitor"
 	AzureMonitor *AzureMonitor `json:"azureMonitor,omitempty"`
@@ -131,6 +141,10 @@ type OutputSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="LokiStack"
 	LokiStack *LokiStack `json:"lokiStack,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Amazon S3"
+	S3 *S3 `json:"s3,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Splunk"
 	Splunk *Splunk `json:"splunk,omitempty"`
@@ -291,6 +305,106 @@ type AzureMonitor struct {
 	Tuning *BaseOutputTuningSpec `json:"tuning,omitempty"`
 }
 
+// AzureLogsIngestionAuthType sets the Entra ID authentication type used for the Azure Logs Ingestion API.
+//
+// +kubebuilder:validation:Enum:=clientSecret;workloadIdentity
+type AzureLogsIngestionAuthType string
+
+const (
+	// AzureLogsIngestionAuthTypeClientSecret authenticates an application with the client credentials flow
+	AzureLogsIngestionAuthTypeClientSecret AzureLogsIngestionAuthType = "clientSecret"
+
+	// AzureLogsIngestionAuthTypeWorkloadIdentity authenticates an application by exchanging a service account
+	// token for an Entra ID token using a federated identity credential
+	AzureLogsIngestionAuthTypeWorkloadIdentity AzureLogsIngestionAuthType = "workloadIdentity"
+)
+
+// AzureLogsIngestionAuthentication contains configuration for authenticating requests to an Azure Logs Ingestion output
+// with Microsoft Entra ID (formerly Azure Active Directory).
+//
+// +kubebuilder:validation:XValidation:rule="self.type != 'clientSecret' || has(self.clientSecret)", message="clientSecret is required for authentication type clientSecret"
+// +kubebuilder:validation:XValidation:rule="self.type != 'workloadIdentity' || has(self.token)", message="token is required for authentication type workloadIdentity"
+type AzureLogsIngestionAuthentication struct {
+	// Type is the type of Entra ID authentication to configure
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authentication Type"
+	Type AzureLogsIngestionAuthType `json:"type"`
+
+	// TenantID points to the secret containing the ID of the Entra ID tenant of the application.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Secret with Tenant ID"
+	TenantID SecretReference `json:"tenantId"`
+
+	// ClientID points to the secret containing the client ID of the application.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Secret with Client ID"
+	ClientID SecretReference `json:"clientId"`
+
+	// ClientSecret points to the secret containing the client secret of the application.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Secret with Client Secret"
+	ClientSecret *SecretReference `json:"clientSecret,omitempty"`
+
+	// Token specifies the service account token which is exchanged for an Entra ID token.
+	//
+	// A token from the service account is projected with the audience 'api://AzureADTokenExchange'.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Token"
+	Token *BearerToken `json:"token,omitempty"`
+
+	// AuthorityHost is the URL of the Entra ID authority to request tokens from (for example for sovereign clouds).
+	// If not set, https://login.microsoftonline.com is used.
+	//
+	// The authority host is shared by all the azureLogsIngestion outputs of a forwarder.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == '' || isURL(self)", message="invalid URL"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authority Host",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	AuthorityHost string `json:"authorityHost,omitempty"`
+}
+
+// AzureLogsIngestion provides configuration for the output type `azureLogsIngestion` which sends logs
+// to Azure Monitor with the Logs Ingestion API using a data collection rule (DCR).
+type AzureLogsIngestion struct {
+	// URL is the data collection endpoint (DCE) or the logs ingestion endpoint of the data collection rule.
+	//
+	// Example: https://my-dce-5kyl.eastus-1.ingest.monitor.azure.com
+	URLSpec `json:",inline"`
+
+	// DCRImmutableID is the immutable ID of the data collection rule.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:="^dcr-[a-f0-9]{32}$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="DCR Immutable ID",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	DCRImmutableID string `json:"dcrImmutableId"`
+
+	// StreamName is the name of the stream of the data collection rule to which logs are sent (e.g. Custom-MyTable_CL).
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:="^(Custom|Microsoft)-[a-zA-Z0-9_-]+$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Stream Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	StreamName string `json:"streamName"`
+
+	// Authentication sets credentials for authenticating the requests.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authentication Options"
+	Authentication *AzureLogsIngestionAuthentication `json:"authentication"`
+
+	// Tuning specs tuning for the output
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tuning Options"
+	Tuning *BaseOutputTuningSpec `json:"tuning,omitempty"`
+}
+
 type CloudwatchTuningSpec struct {
 	BaseOutputTuningSpec `json:",inline"`
 
@@ -391,6 +505,28 @@ type CloudwatchAuthentication struct {
 	IAMRole *CloudwatchIAMRole `json:"iamRole,omitempty"`
 }
 
+// AWSAuthentication contains configuration for signing requests with AWS Signature Version 4 (SigV4).
+// The credentials are configured the same way as for a Cloudwatch output.
+type AWSAuthentication struct {
+	CloudwatchAuthentication `json:",inline"`
+
+	// Region is the AWS region of the service receiving the requests.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Amazon Region",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Region string `json:"region"`
+
+	// ServiceName is the signing name of the service receiving the requests.
+	// Use `es` for Amazon OpenSearch Service and `aoss` for Amazon OpenSearch Serverless.
+	// Elasticsearch outputs only support `es` and `aoss`.
+	//
+	// Defaults to `es`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Service Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ServiceName string `json:"serviceName,omitempty"`
+}
+
 type CloudwatchIAMRole struct {
 	// RoleARN points to the secret containing the role ARN to be used for authentication.
 	// This is used for authentication in STS-enabled clusters.
@@ -420,6 +556,106 @@ type CloudwatchAWSAccessKey struct {
 	KeySecret SecretReference `json:"keySecret"`
 }
 
+type S3TuningSpec struct {
+	BaseOutputTuningSpec `json:",inline"`
+
+	// Compression causes data to be compressed before sending over the network.
+	// If not set, gzip is used.
+	//
+	// +kubebuilder:validation:Enum:=gzip;none;snappy;zlib;zstd
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Compression"
+	Compression string `json:"compression,omitempty"`
+
+	// BatchTimeout is the maximum time in seconds to wait for a batch to fill before it is written as an object to the bucket.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Batch Timeout",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	BatchTimeout int `json:"batchTimeout,omitempty"`
+}
+
+// S3StorageClass is the storage class of the objects written to an S3 bucket.
+//
+// +kubebuilder:validation:Enum:=STANDARD;REDUCED_REDUNDANCY;INTELLIGENT_TIERING;STANDARD_IA;ONEZONE_IA;GLACIER;GLACIER_IR;DEEP_ARCHIVE
+type S3StorageClass string
+
+const (
+	S3StorageClassStandard           S3StorageClass = "STANDARD"
+	S3StorageClassReducedRedundancy  S3StorageClass = "REDUCED_REDUNDANCY"
+	S3StorageClassIntelligentTiering S3StorageClass = "INTELLIGENT_TIERING"
+	S3StorageClassStandardIA         S3StorageClass = "STANDARD_IA"
+	S3StorageClassOneZoneIA          S3StorageClass = "ONEZONE_IA"
+	S3StorageClassGlacier            S3StorageClass = "GLACIER"
+	S3StorageClassGlacierIR          S3StorageClass = "GLACIER_IR"
+	S3StorageClassDeepArchive        S3StorageClass = "DEEP_ARCHIVE"
+)
+
+// S3 provides configuration for the output type `s3` to archive logs as objects in an Amazon S3 or S3-compatible bucket
+type S3 struct {
+	// URL of the S3 API endpoint.
+	//
+	// Required for S3-compatible object stores (e.g. MinIO). If absent, the Amazon S3 endpoint of the region is used.
+	//
+	// The 'username@password' part of `url` is ignored.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == '' ||  isURL(self)", message="invalid URL"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Endpoint URL",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	URL string `json:"url,omitempty"`
+
+	// Authentication sets credentials for authenticating the requests.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authentication Options"
+	Authentication *CloudwatchAuthentication `json:"authentication"`
+
+	// Tuning specs tuning for the output
+	//
+	// +kubebuilder:validation:Optional
+	// +nullable
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tuning Options"
+	Tuning *S3TuningSpec `json:"tuning,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Amazon Region",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Region string `json:"region"`
+
+	// Bucket is the name of the bucket to which objects are written.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Bucket",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Bucket string `json:"bucket"`
+
+	// KeyPrefix is the prefix of the keys of the objects written to the bucket. A trailing '/' is added
+	// to the evaluated prefix so that objects are grouped under it like in a directory.
+	//
+	// The KeyPrefix can be a combination of static and dynamic values consisting of field paths followed by `||` followed by another field path or a static value.
+	//
+	// A dynamic value is encased in single curly brackets `{}` and MUST end with a static fallback value separated with `||`.
+	//
+	// Static values can only contain alphanumeric characters along with dashes, underscores, dots and forward slashes.
+	//
+	// If not set, the prefix is the log type of the record (e.g. application/)
+	//
+	// Example:
+	//
+	//  1. archive/{.log_type||"none"}
+	//
+	//  2. {.kubernetes.namespace_name||"cluster"}/{.log_type||"none"}
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key Prefix",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+
+	// StorageClass is the storage class of the objects written to the bucket. If not set, the default storage class of the bucket is used.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Storage Class"
+	StorageClass S3StorageClass `json:"storageClass,omitempty"`
+}
+
 type ElasticsearchTuningSpec struct {
 	BaseOutputTuningSpec `json:",inline"`
 
@@ -430,6 +666,65 @@ type ElasticsearchTuningSpec struct {
 	Compression string `json:"compression,omitempty"`
 }
 
+// ElasticsearchAuthentication contains configuration for authenticating requests to an Elasticsearch output.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.apiKey) || (!has(self.username) && !has(self.password) && !has(self.token))", message="apiKey cannot be combined with username, password or token"
+// +kubebuilder:validation:XValidation:rule="!has(self.aws) || (!has(self.apiKey) && !has(self.username) && !has(self.password) && !has(self.token))", message="aws cannot be combined with apiKey, username, password or token"
+type ElasticsearchAuthentication struct {
+	HTTPAuthentication `json:",inline"`
+
+	// AWS signs the requests with AWS Signature Version 4 for Amazon OpenSearch Service.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="AWS Authentication"
+	AWS *AWSAuthentication `json:"aws,omitempty"`
+
+	// APIKey points to the secret containing the base64 encoded API key (the `encoded` value returned by Elasticsearch)
+	// used for authenticating requests.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="API Key"
+	APIKey *SecretReference `json:"apiKey,omitempty"`
+}
+
+// ElasticsearchDataStream configures writing logs to data streams named `<type>-<dataset>-<namespace>`.
+// Each name part supports the same template syntax as the index.
+type ElasticsearchDataStream struct {
+	// Type is the type of the data stream.
+	//
+	// Defaults to `logs`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Type",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Type string `json:"type,omitempty"`
+
+	// Dataset is the dataset of the data stream which describes the ingested data.
+	//
+	// Defaults to `generic`
+	//
+	// Example: `{.kubernetes.namespace_name||"none"}`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Dataset",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Dataset string `json:"dataset,omitempty"`
+
+	// Namespace is the user defined namespace of the data stream used to group data.
+	//
+	// Defaults to `default`
+	//
+	// Example: `{.log_type||"none"}`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespace",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="has(self.index) || has(self.dataStream)", message="index or dataStream required"
 type Elasticsearch struct {
 	URLSpec `json:",inline"`
 
@@ -437,7 +732,7 @@ type Elasticsearch struct {
 	//
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authentication Options"
-	Authentication *HTTPAuthentication `json:"authentication,omitempty"`
+	Authentication *ElasticsearchAuthentication `json:"authentication,omitempty"`
 
 	// Tuning specs tuning for the output
 	//
@@ -461,9 +756,34 @@ type Elasticsearch struct {
 	//
 	//  3. foo.{.bar.baz||.qux.quux.corge||.grault||"nil"}-waldo.fred{.plugh||"none"}
 	//
+	// The Index is not used when writing to data streams.
+	//
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Index",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
-	Index string `json:"index"`
+	Index string `json:"index,omitempty"`
+
+	// DataStream writes the logs to data streams instead of the index. Data streams require Elasticsearch version 7 or later.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Data Stream"
+	DataStream *ElasticsearchDataStream `json:"dataStream,omitempty"`
+
+	// Pipeline is the name of the ingest pipeline applied to the logs by Elasticsearch.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Ingest Pipeline",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Pipeline string `json:"pipeline,omitempty"`
+
+	// IDFields is the list of fields hashed into the ID of each document. Retried requests of a record
+	// reuse the same ID which prevents duplicate documents.
+	//
+	// When not set, Elasticsearch generates the ID of the documents.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Document ID Fields"
+	IDFields []FieldPath `json:"idFields,omitempty"`
 
 	// Version specifies the version of Elasticsearch to be used.
 	// Must be one of: 6-8
@@ -564,6 +884,20 @@ type HTTPTuningSpec struct {
 	Compression string `json:"compression,omitempty"`
 }
 
+// HTTPOutputAuthentication contains configuration for authenticating requests to an HTTP output.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.aws) || (!has(self.username) && !has(self.password) && !has(self.token))", message="aws cannot be combined with username, password or token"
+type HTTPOutputAuthentication struct {
+	HTTPAuthentication `json:",inline"`
+
+	// AWS signs the requests with AWS Signature Version 4.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="AWS Authentication"
+	AWS *AWSAuthentication `json:"aws,omitempty"`
+}
+
 // HTTP provided configuration for sending json encoded logs to a generic HTTP endpoint.
 type HTTP struct {
 	URLSpec `json:",inline"`
@@ -572,7 +906,7 @@ type HTTP struct {
 	//
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authentication Options"
-	Authentication *HTTPAuthentication `json:"authentication,omitempty"`
+	Authentication *HTTPOutputAuthentication `json:"authentication,omitempty"`
 
 	// Tuning specs tuning for the output
 	//
@@ -627,6 +961,8 @@ type KafkaAuthentication struct {
 	SASL *SASLAuthentication `json:"sasl,omitempty"`
 }
 
+// +kubebuilder:validation:XValidation:rule="!has(self.mechanism) || self.mechanism != 'OAUTHBEARER' || has(self.oauthBearer)", message="oauthBearer is required when mechanism is OAUTHBEARER"
+// +kubebuilder:validation:XValidation:rule="!has(self.oauthBearer) || (has(self.mechanism) && self.mechanism == 'OAUTHBEARER')", message="oauthBearer requires mechanism OAUTHBEARER"
 type SASLAuthentication struct {
 	// Username points to the secret to be used as SASL username.
 	//
@@ -640,11 +976,50 @@ type SASLAuthentication struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Secret with Password"
 	Password *SecretReference `json:"password,omitempty"`
 
-	// Mechanism sets the SASL mechanism to use.
+	// Mechanism sets the SASL mechanism to use (e.g. PLAIN, SCRAM-SHA-256, SCRAM-SHA-512, OAUTHBEARER).
 	//
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="SASL Mechanism",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	Mechanism string `json:"mechanism,omitempty"`
+
+	// OAuthBearer contains the OAuth 2.0 client credentials used to fetch a token when the mechanism is OAUTHBEARER.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="OAUTHBEARER Options"
+	OAuthBearer *SASLOAuthBearer `json:"oauthBearer,omitempty"`
+}
+
+const (
+	SASLMechanismOAuthBearer = "OAUTHBEARER"
+)
+
+// SASLOAuthBearer contains the OAuth 2.0 client credentials grant configuration for SASL OAUTHBEARER authentication.
+type SASLOAuthBearer struct {
+	// TokenURL is the URL of the token endpoint of the OAuth 2.0 authorization server.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="isURL(self)", message="invalid URL"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Token URL",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	TokenURL string `json:"tokenURL"`
+
+	// ClientID points to the secret containing the OAuth 2.0 client ID.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Secret with Client ID"
+	ClientID SecretReference `json:"clientID"`
+
+	// ClientSecret points to the secret containing the OAuth 2.0 client secret.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Secret with Client Secret"
+	ClientSecret SecretReference `json:"clientSecret"`
+
+	// Scope is the space separated list of scopes requested with the token.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Scope",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Scope string `json:"scope,omitempty"`
 }
 
 // Kafka provides optional extra properties for `type: kafka`
@@ -704,6 +1079,44 @@ type Kafka struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kafka Brokers"
 	Brokers []URL `json:"brokers,omitempty"`
+
+	// KeyField specifies the message key. Messages with the same key are written to the same partition which
+	// preserves their order. Messages are distributed across partitions when not specified.
+	//
+	// The KeyField uses the same template syntax as the Topic.
+	//
+	// Example:
+	//
+	//  1. {.kubernetes.namespace_name||"none"}-{.kubernetes.pod_name||"none"}
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Message Key",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	KeyField string `json:"keyField,omitempty"`
+
+	// Headers specify the headers added to each message. The names must only contain alphanumeric characters and `_.-`.
+	// The values use the same template syntax as the Topic and can be static or reference fields of the record.
+	//
+	// Example:
+	//
+	//  cluster_id: '{.openshift.cluster_id||"none"}'
+	//
+	//  log_type: '{.log_type||"none"}'
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Headers"
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// LibrdkafkaOptions specifies additional options passed to the librdkafka client of the collector
+	// (e.g. acks, enable.idempotence). Options configuring the brokers, SASL or TLS are managed by the
+	// authentication and TLS settings of the output and cannot be specified. The names must only contain
+	// lowercase alphanumeric characters and `_.`
+	//
+	// See https://github.com/confluentinc/librdkafka/blob/master/CONFIGURATION.md
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="librdkafka Options"
+	LibrdkafkaOptions map[string]string `json:"librdkafkaOptions,omitempty"`
 }
 
 // +kubebuilder:validation:XValidation:rule="isURL(self)", message="invalid URL"
@@ -14,6 +14,22 @@ import (
 	timex "time"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSAuthentication) DeepCopyInto(out *AWSAuthentication) {
+	*out = *in
+	in.CloudwatchAuthentication.DeepCopyInto(&out.CloudwatchAuthentication)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSAuthentication.
+func (in *AWSAuthentication) DeepCopy() *AWSAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Application) DeepCopyInto(out *Application) {
 	*out = *in
@@ -22,6 +38,16 @@ func (in *Application) DeepCopyInto(out *Application) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Streams != nil {
+		in, out := &in.Streams, &out.Streams
+		*out = make([]ContainerStream, len(*in))
+		copy(*out, *in)
+	}
 	if in.Tuning != nil {
 		in, out := &in.Tuning, &out.Tuning
 		*out = new(ContainerInputTuningSpec)
@@ -69,6 +95,59 @@ func (in *Audit) DeepCopy() *Audit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureLogsIngestion) DeepCopyInto(out *AzureLogsIngestion) {
+	*out = *in
+	out.URLSpec = in.URLSpec
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AzureLogsIngestionAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(BaseOutputTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureLogsIngestion.
+func (in *AzureLogsIngestion) DeepCopy() *AzureLogsIngestion {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureLogsIngestion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureLogsIngestionAuthentication) DeepCopyInto(out *AzureLogsIngestionAuthentication) {
+	*out = *in
+	out.TenantID = in.TenantID
+	out.ClientID = in.ClientID
+	if in.ClientSecret != nil {
+		in, out := &in.ClientSecret, &out.ClientSecret
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(BearerToken)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureLogsIngestionAuthentication.
+func (in *AzureLogsIngestionAuthentication) DeepCopy() *AzureLogsIngestionAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureLogsIngestionAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureMonitor) DeepCopyInto(out *AzureMonitor) {
 	*out = *in
@@ -477,7 +556,7 @@ func (in *ContainerInputTuningSpec) DeepCopyInto(out *ContainerInputTuningSpec)
 	if in.RateLimitPerContainer != nil {
 		in, out := &in.RateLimitPerContainer, &out.RateLimitPerContainer
 		*out = new(LimitSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -491,6 +570,76 @@ func (in *ContainerInputTuningSpec) DeepCopy() *ContainerInputTuningSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DedupeFilterSpec) DeepCopyInto(out *DedupeFilterSpec) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]FieldPath, len(*in))
+		copy(*out, *in)
+	}
+	if in.CacheSize != nil {
+		in, out := &in.CacheSize, &out.CacheSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WindowMs != nil {
+		in, out := &in.WindowMs, &out.WindowMs
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DedupeFilterSpec.
+func (in *DedupeFilterSpec) DeepCopy() *DedupeFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DedupeFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DetectMultilineExceptionSpec) DeepCopyInto(out *DetectMultilineExceptionSpec) {
+	*out = *in
+	if in.Languages != nil {
+		in, out := &in.Languages, &out.Languages
+		*out = make([]MultilineExceptionLanguage, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpireAfterMs != nil {
+		in, out := &in.ExpireAfterMs, &out.ExpireAfterMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FlushIntervalMs != nil {
+		in, out := &in.FlushIntervalMs, &out.FlushIntervalMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GroupBy != nil {
+		in, out := &in.GroupBy, &out.GroupBy
+		*out = make([]FieldPath, len(*in))
+		copy(*out, *in)
+	}
+	if in.Patterns != nil {
+		in, out := &in.Patterns, &out.Patterns
+		*out = new(MultilinePatterns)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DetectMultilineExceptionSpec.
+func (in *DetectMultilineExceptionSpec) DeepCopy() *DetectMultilineExceptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DetectMultilineExceptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DropCondition) DeepCopyInto(out *DropCondition) {
 	*out = *in
@@ -532,7 +681,7 @@ func (in *Elasticsearch) DeepCopyInto(out *Elasticsearch) {
 	out.URLSpec = in.URLSpec
 	if in.Authentication != nil {
 		in, out := &in.Authentication, &out.Authentication
-		*out = new(HTTPAuthentication)
+		*out = new(ElasticsearchAuthentication)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Tuning != nil {
@@ -540,6 +689,16 @@ func (in *Elasticsearch) DeepCopyInto(out *Elasticsearch) {
 		*out = new(ElasticsearchTuningSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DataStream != nil {
+		in, out := &in.DataStream, &out.DataStream
+		*out = new(ElasticsearchDataStream)
+		**out = **in
+	}
+	if in.IDFields != nil {
+		in, out := &in.IDFields, &out.IDFields
+		*out = make([]FieldPath, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Elasticsearch.
@@ -552,6 +711,47 @@ func (in *Elasticsearch) DeepCopy() *Elasticsearch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchAuthentication) DeepCopyInto(out *ElasticsearchAuthentication) {
+	*out = *in
+	in.HTTPAuthentication.DeepCopyInto(&out.HTTPAuthentication)
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(AWSAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIKey != nil {
+		in, out := &in.APIKey, &out.APIKey
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchAuthentication.
+func (in *ElasticsearchAuthentication) DeepCopy() *ElasticsearchAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchDataStream) DeepCopyInto(out *ElasticsearchDataStream) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchDataStream.
+func (in *ElasticsearchDataStream) DeepCopy() *ElasticsearchDataStream {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchDataStream)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ElasticsearchTuningSpec) DeepCopyInto(out *ElasticsearchTuningSpec) {
 	*out = *in
@@ -571,6 +771,13 @@ func (in *ElasticsearchTuningSpec) DeepCopy() *ElasticsearchTuningSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FilterSpec) DeepCopyInto(out *FilterSpec) {
 	*out = *in
+	if in.When != nil {
+		in, out := &in.When, &out.When
+		*out = make([]DropTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.KubeAPIAudit != nil {
 		in, out := &in.KubeAPIAudit, &out.KubeAPIAudit
 		*out = new(KubeAPIAudit)
@@ -595,6 +802,41 @@ func (in *FilterSpec) DeepCopyInto(out *FilterSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.ParseFilterSpec != nil {
+		in, out := &in.ParseFilterSpec, &out.ParseFilterSpec
+		*out = new(ParseFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DetectMultilineExceptionSpec != nil {
+		in, out := &in.DetectMultilineExceptionSpec, &out.DetectMultilineExceptionSpec
+		*out = new(DetectMultilineExceptionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemapFilterSpec != nil {
+		in, out := &in.RemapFilterSpec, &out.RemapFilterSpec
+		*out = new(RemapFilterSpec)
+		**out = **in
+	}
+	if in.SampleFilterSpec != nil {
+		in, out := &in.SampleFilterSpec, &out.SampleFilterSpec
+		*out = new(SampleFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DedupeFilterSpec != nil {
+		in, out := &in.DedupeFilterSpec, &out.DedupeFilterSpec
+		*out = new(DedupeFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RedactFilterSpec != nil {
+		in, out := &in.RedactFilterSpec, &out.RedactFilterSpec
+		*out = new(RedactFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ModifyFilterSpec != nil {
+		in, out := &in.ModifyFilterSpec, &out.ModifyFilterSpec
+		*out = new(ModifyFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterSpec.
@@ -607,6 +849,41 @@ func (in *FilterSpec) DeepCopy() *FilterSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForwardReceiver) DeepCopyInto(out *ForwardReceiver) {
+	*out = *in
+	if in.TagNamespaces != nil {
+		in, out := &in.TagNamespaces, &out.TagNamespaces
+		*out = make([]ForwardTagNamespace, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForwardReceiver.
+func (in *ForwardReceiver) DeepCopy() *ForwardReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(ForwardReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForwardTagNamespace) DeepCopyInto(out *ForwardTagNamespace) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForwardTagNamespace.
+func (in *ForwardTagNamespace) DeepCopy() *ForwardTagNamespace {
+	if in == nil {
+		return nil
+	}
+	out := new(ForwardTagNamespace)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GoogleCloudLogging) DeepCopyInto(out *GoogleCloudLogging) {
 	*out = *in
@@ -690,7 +967,7 @@ func (in *HTTP) DeepCopyInto(out *HTTP) {
 	out.URLSpec = in.URLSpec
 	if in.Authentication != nil {
 		in, out := &in.Authentication, &out.Authentication
-		*out = new(HTTPAuthentication)
+		*out = new(HTTPOutputAuthentication)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Tuning != nil {
@@ -747,6 +1024,27 @@ func (in *HTTPAuthentication) DeepCopy() *HTTPAuthentication {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPOutputAuthentication) DeepCopyInto(out *HTTPOutputAuthentication) {
+	*out = *in
+	in.HTTPAuthentication.DeepCopyInto(&out.HTTPAuthentication)
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(AWSAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPOutputAuthentication.
+func (in *HTTPOutputAuthentication) DeepCopy() *HTTPOutputAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPOutputAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPReceiver) DeepCopyInto(out *HTTPReceiver) {
 	*out = *in
@@ -778,6 +1076,31 @@ func (in *HTTPTuningSpec) DeepCopy() *HTTPTuningSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostFiles) DeepCopyInto(out *HostFiles) {
+	*out = *in
+	if in.Includes != nil {
+		in, out := &in.Includes, &out.Includes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Excludes != nil {
+		in, out := &in.Excludes, &out.Excludes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostFiles.
+func (in *HostFiles) DeepCopy() *HostFiles {
+	if in == nil {
+		return nil
+	}
+	out := new(HostFiles)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Infrastructure) DeepCopyInto(out *Infrastructure) {
 	*out = *in
@@ -786,6 +1109,11 @@ func (in *Infrastructure) DeepCopyInto(out *Infrastructure) {
 		*out = make([]InfrastructureSource, len(*in))
 		copy(*out, *in)
 	}
+	if in.Journal != nil {
+		in, out := &in.Journal, &out.Journal
+		*out = new(Journal)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Infrastructure.
@@ -821,6 +1149,16 @@ func (in *InputSpec) DeepCopyInto(out *InputSpec) {
 		*out = new(ReceiverSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = new(KubernetesEvents)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostFiles != nil {
+		in, out := &in.HostFiles, &out.HostFiles
+		*out = new(HostFiles)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InputSpec.
@@ -868,6 +1206,51 @@ func (in *InputTLSSpec) DeepCopy() *InputTLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Journal) DeepCopyInto(out *Journal) {
+	*out = *in
+	if in.IncludeUnits != nil {
+		in, out := &in.IncludeUnits, &out.IncludeUnits
+		*out = make([]SystemdUnit, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeUnits != nil {
+		in, out := &in.ExcludeUnits, &out.ExcludeUnits
+		*out = make([]SystemdUnit, len(*in))
+		copy(*out, *in)
+	}
+	if in.CurrentBootOnly != nil {
+		in, out := &in.CurrentBootOnly, &out.CurrentBootOnly
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Matches != nil {
+		in, out := &in.Matches, &out.Matches
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Journal.
+func (in *Journal) DeepCopy() *Journal {
+	if in == nil {
+		return nil
+	}
+	out := new(Journal)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kafka) DeepCopyInto(out *Kafka) {
 	*out = *in
@@ -886,6 +1269,20 @@ func (in *Kafka) DeepCopyInto(out *Kafka) {
 		*out = make([]URL, len(*in))
 		copy(*out, *in)
 	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LibrdkafkaOptions != nil {
+		in, out := &in.LibrdkafkaOptions, &out.LibrdkafkaOptions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kafka.
@@ -975,14 +1372,54 @@ func (in *KubeAPIAudit) DeepCopy() *KubeAPIAudit {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LimitSpec) DeepCopyInto(out *LimitSpec) {
+func (in *KubernetesEvents) DeepCopyInto(out *KubernetesEvents) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitSpec.
-func (in *LimitSpec) DeepCopy() *LimitSpec {
-	if in == nil {
-		return nil
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InvolvedObjectKinds != nil {
+		in, out := &in.InvolvedObjectKinds, &out.InvolvedObjectKinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]KubernetesEventType, len(*in))
+		copy(*out, *in)
+	}
+	if in.PollIntervalSeconds != nil {
+		in, out := &in.PollIntervalSeconds, &out.PollIntervalSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesEvents.
+func (in *KubernetesEvents) DeepCopy() *KubernetesEvents {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesEvents)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LimitSpec) DeepCopyInto(out *LimitSpec) {
+	*out = *in
+	if in.WindowSeconds != nil {
+		in, out := &in.WindowSeconds, &out.WindowSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitSpec.
+func (in *LimitSpec) DeepCopy() *LimitSpec {
+	if in == nil {
+		return nil
 	}
 	out := new(LimitSpec)
 	in.DeepCopyInto(out)
@@ -1157,6 +1594,63 @@ func (in *LokiTuningSpec) DeepCopy() *LokiTuningSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModifyFilterSpec) DeepCopyInto(out *ModifyFilterSpec) {
+	*out = *in
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]ModifyOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModifyFilterSpec.
+func (in *ModifyFilterSpec) DeepCopy() *ModifyFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModifyFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModifyOperation) DeepCopyInto(out *ModifyOperation) {
+	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(ValueReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModifyOperation.
+func (in *ModifyOperation) DeepCopy() *ModifyOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(ModifyOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultilinePatterns) DeepCopyInto(out *MultilinePatterns) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultilinePatterns.
+func (in *MultilinePatterns) DeepCopy() *MultilinePatterns {
+	if in == nil {
+		return nil
+	}
+	out := new(MultilinePatterns)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespaceContainerSpec) DeepCopyInto(out *NamespaceContainerSpec) {
 	*out = *in
@@ -1197,6 +1691,26 @@ func (in *OTLP) DeepCopy() *OTLP {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPReceiver) DeepCopyInto(out *OTLPReceiver) {
+	*out = *in
+	if in.GRPCPort != nil {
+		in, out := &in.GRPCPort, &out.GRPCPort
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPReceiver.
+func (in *OTLPReceiver) DeepCopy() *OTLPReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OTLPTuningSpec) DeepCopyInto(out *OTLPTuningSpec) {
 	*out = *in
@@ -1224,7 +1738,12 @@ func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
 	if in.Limit != nil {
 		in, out := &in.Limit, &out.Limit
 		*out = new(LimitSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AzureLogsIngestion != nil {
+		in, out := &in.AzureLogsIngestion, &out.AzureLogsIngestion
+		*out = new(AzureLogsIngestion)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.AzureMonitor != nil {
 		in, out := &in.AzureMonitor, &out.AzureMonitor
@@ -1266,6 +1785,11 @@ func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
 		*out = new(LokiStack)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Splunk != nil {
 		in, out := &in.Splunk, &out.Splunk
 		*out = new(Splunk)
@@ -1314,6 +1838,91 @@ func (in *OutputTLSSpec) DeepCopy() *OutputTLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParseCSV) DeepCopyInto(out *ParseCSV) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParseCSV.
+func (in *ParseCSV) DeepCopy() *ParseCSV {
+	if in == nil {
+		return nil
+	}
+	out := new(ParseCSV)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParseFilterSpec) DeepCopyInto(out *ParseFilterSpec) {
+	*out = *in
+	if in.LogSources != nil {
+		in, out := &in.LogSources, &out.LogSources
+		*out = make([]LogSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.Regex != nil {
+		in, out := &in.Regex, &out.Regex
+		*out = new(ParseRegex)
+		**out = **in
+	}
+	if in.CSV != nil {
+		in, out := &in.CSV, &out.CSV
+		*out = new(ParseCSV)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeyValue != nil {
+		in, out := &in.KeyValue, &out.KeyValue
+		*out = new(ParseKeyValue)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParseFilterSpec.
+func (in *ParseFilterSpec) DeepCopy() *ParseFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ParseFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParseKeyValue) DeepCopyInto(out *ParseKeyValue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParseKeyValue.
+func (in *ParseKeyValue) DeepCopy() *ParseKeyValue {
+	if in == nil {
+		return nil
+	}
+	out := new(ParseKeyValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParseRegex) DeepCopyInto(out *ParseRegex) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParseRegex.
+func (in *ParseRegex) DeepCopy() *ParseRegex {
+	if in == nil {
+		return nil
+	}
+	out := new(ParseRegex)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 	*out = *in
@@ -1332,6 +1941,18 @@ func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]RouteSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DefaultOutputRefs != nil {
+		in, out := &in.DefaultOutputRefs, &out.DefaultOutputRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineSpec.
@@ -1369,6 +1990,41 @@ func (in *PruneFilterSpec) DeepCopy() *PruneFilterSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReceiverAuthentication) DeepCopyInto(out *ReceiverAuthentication) {
+	*out = *in
+	if in.ClientCA != nil {
+		in, out := &in.ClientCA, &out.ClientCA
+		*out = new(ValueReference)
+		**out = **in
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverAuthentication.
+func (in *ReceiverAuthentication) DeepCopy() *ReceiverAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(ReceiverAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReceiverSpec) DeepCopyInto(out *ReceiverSpec) {
 	*out = *in
@@ -1377,11 +2033,31 @@ func (in *ReceiverSpec) DeepCopyInto(out *ReceiverSpec) {
 		*out = new(InputTLSSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(ReceiverAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.HTTP != nil {
 		in, out := &in.HTTP, &out.HTTP
 		*out = new(HTTPReceiver)
 		**out = **in
 	}
+	if in.Syslog != nil {
+		in, out := &in.Syslog, &out.Syslog
+		*out = new(SyslogReceiver)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(OTLPReceiver)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Forward != nil {
+		in, out := &in.Forward, &out.Forward
+		*out = new(ForwardReceiver)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverSpec.
@@ -1394,6 +2070,149 @@ func (in *ReceiverSpec) DeepCopy() *ReceiverSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedactFilterSpec) DeepCopyInto(out *RedactFilterSpec) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]FieldPath, len(*in))
+		copy(*out, *in)
+	}
+	if in.Patterns != nil {
+		in, out := &in.Patterns, &out.Patterns
+		*out = make([]RedactPattern, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomPatterns != nil {
+		in, out := &in.CustomPatterns, &out.CustomPatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Replacement != nil {
+		in, out := &in.Replacement, &out.Replacement
+		*out = new(RedactReplacement)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedactFilterSpec.
+func (in *RedactFilterSpec) DeepCopy() *RedactFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedactFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedactReplacement) DeepCopyInto(out *RedactReplacement) {
+	*out = *in
+	if in.Salt != nil {
+		in, out := &in.Salt, &out.Salt
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.RevealLength != nil {
+		in, out := &in.RevealLength, &out.RevealLength
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedactReplacement.
+func (in *RedactReplacement) DeepCopy() *RedactReplacement {
+	if in == nil {
+		return nil
+	}
+	out := new(RedactReplacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemapFilterSpec) DeepCopyInto(out *RemapFilterSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemapFilterSpec.
+func (in *RemapFilterSpec) DeepCopy() *RemapFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RemapFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteSpec) DeepCopyInto(out *RouteSpec) {
+	*out = *in
+	if in.Tests != nil {
+		in, out := &in.Tests, &out.Tests
+		*out = make([]DropTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OutputRefs != nil {
+		in, out := &in.OutputRefs, &out.OutputRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpec.
+func (in *RouteSpec) DeepCopy() *RouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3) DeepCopyInto(out *S3) {
+	*out = *in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(CloudwatchAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(S3TuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3.
+func (in *S3) DeepCopy() *S3 {
+	if in == nil {
+		return nil
+	}
+	out := new(S3)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3TuningSpec) DeepCopyInto(out *S3TuningSpec) {
+	*out = *in
+	in.BaseOutputTuningSpec.DeepCopyInto(&out.BaseOutputTuningSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3TuningSpec.
+func (in *S3TuningSpec) DeepCopy() *S3TuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(S3TuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SASLAuthentication) DeepCopyInto(out *SASLAuthentication) {
 	*out = *in
@@ -1407,6 +2226,11 @@ func (in *SASLAuthentication) DeepCopyInto(out *SASLAuthentication) {
 		*out = new(SecretReference)
 		**out = **in
 	}
+	if in.OAuthBearer != nil {
+		in, out := &in.OAuthBearer, &out.OAuthBearer
+		*out = new(SASLOAuthBearer)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SASLAuthentication.
@@ -1419,6 +2243,45 @@ func (in *SASLAuthentication) DeepCopy() *SASLAuthentication {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SASLOAuthBearer) DeepCopyInto(out *SASLOAuthBearer) {
+	*out = *in
+	out.ClientID = in.ClientID
+	out.ClientSecret = in.ClientSecret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SASLOAuthBearer.
+func (in *SASLOAuthBearer) DeepCopy() *SASLOAuthBearer {
+	if in == nil {
+		return nil
+	}
+	out := new(SASLOAuthBearer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SampleFilterSpec) DeepCopyInto(out *SampleFilterSpec) {
+	*out = *in
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]DropTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SampleFilterSpec.
+func (in *SampleFilterSpec) DeepCopy() *SampleFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SampleFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretReference) DeepCopyInto(out *SecretReference) {
 	*out = *in
@@ -1531,6 +2394,26 @@ func (in *Syslog) DeepCopy() *Syslog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogReceiver) DeepCopyInto(out *SyslogReceiver) {
+	*out = *in
+	if in.MaxMessageLength != nil {
+		in, out := &in.MaxMessageLength, &out.MaxMessageLength
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogReceiver.
+func (in *SyslogReceiver) DeepCopy() *SyslogReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyslogTuningSpec) DeepCopyInto(out *SyslogTuningSpec) {
 	*out = *in
@@ -57,6 +57,10 @@ func migrateInputReceiver(spec obs.InputSpec, forwarderName string, options util
 	if spec.Receiver != nil && spec.Receiver.TLS != nil {
 		return spec
 	}
+	// TLS is not supported for receivers which listen for UDP
+	if spec.Receiver.Type == obs.ReceiverTypeSyslog && spec.Receiver.Syslog != nil && spec.Receiver.Syslog.Protocol != "" && spec.Receiver.Syslog.Protocol != obs.SyslogReceiverProtocolTCP {
+		return spec
+	}
 	secretName := fmt.Sprintf("%s-%s", forwarderName, spec.Name)
 	spec.Receiver.TLS = &obs.InputTLSSpec{
 		Key: &obs.SecretReference{
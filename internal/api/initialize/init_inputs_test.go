@@ -152,6 +152,25 @@ var _ = Describe("migrateInputs", func() {
 			migratedSpec := migrate(spec, spec)
 			Expect(migratedSpec.Spec.Inputs).To(Equal(spec.Spec.Inputs))
 		})
+		It("should ignore migration when a syslog receiver listens for udp", func() {
+			spec = obs.ClusterLogForwarder{
+				Spec: obs.ClusterLogForwarderSpec{
+					Inputs: []obs.InputSpec{
+						{
+							Name: "anapp",
+							Type: obs.InputTypeReceiver,
+							Receiver: &obs.ReceiverSpec{
+								Type:   obs.ReceiverTypeSyslog,
+								Syslog: &obs.SyslogReceiver{Protocol: obs.SyslogReceiverProtocolUDP},
+							},
+						},
+					},
+				},
+			}
+
+			migratedSpec := migrate(spec, spec)
+			Expect(migratedSpec.Spec.Inputs).To(Equal(spec.Spec.Inputs))
+		})
 		It("should add TLS settings that match the cert signing service when TLS is not spec'd", func() {
 			spec = obs.ClusterLogForwarder{
 				Spec: obs.ClusterLogForwarderSpec{
@@ -18,11 +18,13 @@ const (
 func MigrateLokiStack(spec obs.ClusterLogForwarder, options utils.Options) obs.ClusterLogForwarder {
 	var outputs []obs.OutputSpec
 	var pipelines []obs.PipelineSpec
+	var filters []obs.FilterSpec
 
-	outputs, pipelines = ProcessForwarderPipelines(spec.Spec)
+	outputs, pipelines, filters = ProcessForwarderPipelines(spec.Spec)
 
 	spec.Spec.Outputs = outputs
 	spec.Spec.Pipelines = pipelines
+	spec.Spec.Filters = append(spec.Spec.Filters, filters...)
 
 	return spec
 }
@@ -113,6 +113,143 @@ var _ = Describe("MigrateLokiStack", func() {
 				}
 			},
 		),
+		Entry("single tenant, lokistack output referenced by routes",
+			obs.ClusterLogForwarderSpec{
+				Pipelines: []obs.PipelineSpec{
+					{
+						Name:       lokistackPipeline,
+						InputRefs:  []string{string(obs.InputTypeApplication)},
+						OutputRefs: []string{lokistackOutApp, esOut},
+						Routes: []obs.RouteSpec{
+							{
+								Name:       "errors",
+								Tests:      []obs.DropTest{{DropConditions: []obs.DropCondition{{Field: ".level", Matches: "error"}}}},
+								OutputRefs: []string{lokistackOutApp},
+							},
+						},
+						DefaultOutputRefs: []string{esOut},
+					},
+				},
+				Outputs: []obs.OutputSpec{
+					esOutSpec,
+					{
+						Name: lokistackOutApp,
+						Type: obs.OutputTypeLoki,
+						Loki: &obs.Loki{
+							URLSpec: obs.URLSpec{
+								URL: "https://test-lokistack-gateway-http.openshift-logging.svc:8080/api/logs/v1/application",
+							},
+							Authentication: &obs.HTTPAuthentication{
+								Token: &obs.BearerToken{
+									From: obs.BearerTokenFromServiceAccount,
+								},
+							},
+						},
+					},
+				},
+			},
+			func(spec *obs.ClusterLogForwarderSpec) {
+				spec.Outputs = append(spec.Outputs, esOutSpec)
+				spec.Pipelines = []obs.PipelineSpec{
+					{
+						Name:       lokistackPipeline,
+						InputRefs:  []string{string(obs.InputTypeApplication)},
+						OutputRefs: []string{lokistackOut, esOut},
+						Routes: []obs.RouteSpec{
+							{
+								Name:       "errors",
+								Tests:      []obs.DropTest{{DropConditions: []obs.DropCondition{{Field: ".level", Matches: "error"}}}},
+								OutputRefs: []string{lokistackOut},
+							},
+						},
+						DefaultOutputRefs: []string{esOut},
+					},
+				}
+			},
+		),
+		Entry("kubernetes events of application and infrastructure namespaces, single lokistack output",
+			obs.ClusterLogForwarderSpec{
+				Inputs: []obs.InputSpec{
+					{
+						Name:   "events",
+						Type:   obs.InputTypeKubernetesEvents,
+						Events: &obs.KubernetesEvents{},
+					},
+				},
+				Pipelines: []obs.PipelineSpec{
+					{
+						Name:       lokistackPipeline + "-application",
+						InputRefs:  []string{"events"},
+						OutputRefs: []string{lokistackOut + "-events-application"},
+						FilterRefs: []string{"lokistack-tenant-application"},
+					},
+					{
+						Name:       lokistackPipeline + "-infrastructure",
+						InputRefs:  []string{"events"},
+						OutputRefs: []string{lokistackOut + "-events-infrastructure"},
+						FilterRefs: []string{"lokistack-tenant-infrastructure"},
+					},
+				},
+				Outputs: []obs.OutputSpec{
+					{
+						Name: lokistackOut + "-events-application",
+						Type: obs.OutputTypeLoki,
+						Loki: &obs.Loki{
+							URLSpec: obs.URLSpec{
+								URL: "https://test-lokistack-gateway-http.openshift-logging.svc:8080/api/logs/v1/application",
+							},
+							Authentication: &obs.HTTPAuthentication{
+								Token: &obs.BearerToken{
+									From: obs.BearerTokenFromServiceAccount,
+								},
+							},
+						},
+					},
+					{
+						Name: lokistackOut + "-events-infrastructure",
+						Type: obs.OutputTypeLoki,
+						Loki: &obs.Loki{
+							URLSpec: obs.URLSpec{
+								URL: "https://test-lokistack-gateway-http.openshift-logging.svc:8080/api/logs/v1/infrastructure",
+							},
+							Authentication: &obs.HTTPAuthentication{
+								Token: &obs.BearerToken{
+									From: obs.BearerTokenFromServiceAccount,
+								},
+							},
+						},
+					},
+				},
+				Filters: []obs.FilterSpec{
+					{
+						Name:          "lokistack-tenant-application",
+						Type:          obs.FilterTypeDrop,
+						DropTestsSpec: []obs.DropTest{{DropConditions: []obs.DropCondition{{Field: ".log_type", NotMatches: "^application$"}}}},
+					},
+					{
+						Name:          "lokistack-tenant-infrastructure",
+						Type:          obs.FilterTypeDrop,
+						DropTestsSpec: []obs.DropTest{{DropConditions: []obs.DropCondition{{Field: ".log_type", NotMatches: "^infrastructure$"}}}},
+					},
+				},
+			},
+			func(spec *obs.ClusterLogForwarderSpec) {
+				spec.Inputs = []obs.InputSpec{
+					{
+						Name:   "events",
+						Type:   obs.InputTypeKubernetesEvents,
+						Events: &obs.KubernetesEvents{},
+					},
+				}
+				spec.Pipelines = []obs.PipelineSpec{
+					{
+						Name:       lokistackPipeline,
+						InputRefs:  []string{"events"},
+						OutputRefs: []string{lokistackOut},
+					},
+				}
+			},
+		),
 		Entry("multiple tenants, single lokistack output",
 			obs.ClusterLogForwarderSpec{
 				Pipelines: []obs.PipelineSpec{
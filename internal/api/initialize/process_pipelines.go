@@ -11,12 +11,15 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
 )
 
-// ProcessForwarderPipelines migrates the specified output type and name to appropriate outputs and pipelines
-func ProcessForwarderPipelines(spec obs.ClusterLogForwarderSpec) ([]obs.OutputSpec, []obs.PipelineSpec) {
+// ProcessForwarderPipelines migrates the specified output type and name to appropriate outputs and pipelines.
+// The records of an input of several log types are split by tenant using the filters which are returned
+func ProcessForwarderPipelines(spec obs.ClusterLogForwarderSpec) ([]obs.OutputSpec, []obs.PipelineSpec, []obs.FilterSpec) {
 	inPipelines := spec.Pipelines
 	pipelines := []obs.PipelineSpec{}
 	outputMap := utils.OutputMap(&spec)
 	finalOutputs := []obs.OutputSpec{}
+	filters := []obs.FilterSpec{}
+	tenantFilters := sets.NewString()
 
 	// Remove migrated outputs
 	for _, o := range spec.Outputs {
@@ -33,44 +36,45 @@ func ProcessForwarderPipelines(spec obs.ClusterLogForwarderSpec) ([]obs.OutputSp
 			continue
 		}
 
-		needOutput := map[string][]obs.OutputSpec{}
-
-		// Make map of input and list of lokistacks for the input
-		for _, i := range p.InputRefs {
-			for _, outputName := range needMigrations.List() {
-				needOutput[i] = append(needOutput[i], *outputMap[outputName])
-			}
-		}
-
-		// Create pipeline for each tenant
+		// Create pipeline for each input and tenant
 		for i, input := range p.InputRefs {
-			pOut := p.DeepCopy()
-			pOut.InputRefs = []string{input}
+			tenants := getInputTenantsFromName(spec, input)
+			for _, tenant := range tenants {
+				pOut := p.DeepCopy()
+				pOut.InputRefs = []string{input}
+
+				suffix := input
+				if len(tenants) > 1 {
+					suffix = fmt.Sprintf("%s-%s", input, tenant)
+					filter := tenantFilter(tenant)
+					if !tenantFilters.Has(filter.Name) {
+						tenantFilters.Insert(filter.Name)
+						filters = append(filters, filter)
+					}
+					pOut.FilterRefs = append(pOut.FilterRefs, filter.Name)
+				}
 
-			for i, output := range pOut.OutputRefs {
-				if !needMigrations.Has(output) {
-					// Leave output names as-is if not needing to migrate
-					continue
+				migrateOutputRefs(pOut.OutputRefs, suffix, needMigrations)
+				for r := range pOut.Routes {
+					migrateOutputRefs(pOut.Routes[r].OutputRefs, suffix, needMigrations)
 				}
-				// Format output name with input
-				pOut.OutputRefs[i] = fmt.Sprintf("%s-%s", output, input)
-			}
+				migrateOutputRefs(pOut.DefaultOutputRefs, suffix, needMigrations)
 
-			// Generate pipeline name
-			if pOut.Name != "" && i > 0 {
-				pOut.Name = fmt.Sprintf("%s-%d", pOut.Name, i)
-			}
+				// Generate pipeline name
+				if pOut.Name != "" && i > 0 {
+					pOut.Name = fmt.Sprintf("%s-%d", pOut.Name, i)
+				}
+				if pOut.Name != "" && len(tenants) > 1 {
+					pOut.Name = fmt.Sprintf("%s-%s", pOut.Name, tenant)
+				}
 
-			pipelines = append(pipelines, *pOut)
-		}
+				pipelines = append(pipelines, *pOut)
 
-		// Create output/s from each input
-		for input, outputSpecList := range needOutput {
-			tenant := getInputTypeFromName(spec, input)
-			for _, outSpec := range outputSpecList {
-				// Generate appropriate OTLP out or loki out
-				obsSpec := GenerateOutput(outSpec, input, tenant)
-				finalOutputs = append(finalOutputs, obsSpec)
+				// Create output/s from each input
+				for _, outputName := range needMigrations.List() {
+					// Generate appropriate OTLP out or loki out
+					finalOutputs = append(finalOutputs, GenerateOutput(*outputMap[outputName], suffix, tenant))
+				}
 			}
 		}
 	}
@@ -80,7 +84,48 @@ func ProcessForwarderPipelines(spec obs.ClusterLogForwarderSpec) ([]obs.OutputSp
 		return strings.Compare(finalOutputs[i].Name, finalOutputs[j].Name) < 0
 	})
 
-	return finalOutputs, pipelines
+	return finalOutputs, pipelines, filters
+}
+
+// tenantFilter returns a filter which drops the records which are not of the log type of a tenant
+func tenantFilter(tenant string) obs.FilterSpec {
+	return obs.FilterSpec{
+		Name: fmt.Sprintf("lokistack-tenant-%s", tenant),
+		Type: obs.FilterTypeDrop,
+		DropTestsSpec: []obs.DropTest{
+			{
+				DropConditions: []obs.DropCondition{
+					{Field: ".log_type", NotMatches: fmt.Sprintf("^%s$", tenant)},
+				},
+			},
+		},
+	}
+}
+
+// migrateOutputRefs formats the names of the outputs needing migration with the input name
+func migrateOutputRefs(outputRefs []string, input string, needMigrations *sets.String) {
+	for i, output := range outputRefs {
+		if !needMigrations.Has(output) {
+			// Leave output names as-is if not needing to migrate
+			continue
+		}
+		// Format output name with input
+		outputRefs[i] = fmt.Sprintf("%s-%s", output, input)
+	}
+}
+
+// getInputTenantsFromName returns the tenants of the records of an input
+func getInputTenantsFromName(spec obs.ClusterLogForwarderSpec, inputName string) []string {
+	for _, input := range spec.Inputs {
+		if input.Name == inputName && input.Events != nil {
+			var tenants []string
+			for _, logType := range internalobs.KubernetesEventsLogTypes(*input.Events) {
+				tenants = append(tenants, string(logType))
+			}
+			return tenants
+		}
+	}
+	return []string{getInputTypeFromName(spec, inputName)}
 }
 
 func getInputTypeFromName(spec obs.ClusterLogForwarderSpec, inputName string) string {
@@ -94,12 +139,22 @@ func getInputTypeFromName(spec obs.ClusterLogForwarderSpec, inputName string) st
 			if input.Application != nil {
 				return string(obs.InputTypeApplication)
 			}
+			if input.HostFiles != nil {
+				return string(obs.InputTypeInfrastructure)
+			}
 			if input.Infrastructure != nil || input.Receiver.Type == obs.ReceiverTypeSyslog {
 				return string(obs.InputTypeInfrastructure)
 			}
 			if input.Audit != nil || (input.Receiver.Type == obs.ReceiverTypeHTTP && input.Receiver.HTTP != nil && input.Receiver.HTTP.Format == obs.HTTPReceiverFormatKubeAPIAudit) {
 				return string(obs.InputTypeAudit)
 			}
+			if input.Receiver != nil && (input.Receiver.Type == obs.ReceiverTypeOTLP || input.Receiver.Type == obs.ReceiverTypeForward) {
+				return string(obs.InputTypeApplication)
+			}
+			if input.Receiver != nil && input.Receiver.Type == obs.ReceiverTypeHTTP && input.Receiver.HTTP != nil {
+				logType, _ := internalobs.HTTPReceiverLogTypeAndSource(*input.Receiver.HTTP)
+				return string(logType)
+			}
 		}
 	}
 	return ""
@@ -1,6 +1,9 @@
 package observability
 
-import obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"k8s.io/utils/set"
+)
 
 // FilterMap returns a map of filter names to FilterSpec.
 func FilterMap(spec obs.ClusterLogForwarderSpec) map[string]*obs.FilterSpec {
@@ -20,3 +23,49 @@ func (filters Filters) Names() (names []string) {
 	}
 	return names
 }
+
+// ConfigmapNames returns a unique set of unordered configmap names
+func (filters Filters) ConfigmapNames() []string {
+	names := set.New[string]()
+	for _, f := range filters {
+		for _, r := range FilterValueReferences(f) {
+			if r.ConfigMapName != "" {
+				names.Insert(r.ConfigMapName)
+			}
+		}
+	}
+	return names.UnsortedList()
+}
+
+// SecretNames returns a unique set of unordered secret names
+func (filters Filters) SecretNames() []string {
+	secrets := set.New[string]()
+	for _, f := range filters {
+		for _, r := range FilterValueReferences(f) {
+			if r.SecretName != "" {
+				secrets.Insert(r.SecretName)
+			}
+		}
+	}
+	return secrets.UnsortedList()
+}
+
+// FilterValueReferences returns a list of the secret and configmap keys referenced by a filter
+func FilterValueReferences(f obs.FilterSpec) (refs []*obs.ValueReference) {
+	switch f.Type {
+	case obs.FilterTypeRedact:
+		if f.RedactFilterSpec != nil && f.RedactFilterSpec.Replacement != nil && f.RedactFilterSpec.Replacement.Salt != nil {
+			salt := f.RedactFilterSpec.Replacement.Salt
+			refs = append(refs, &obs.ValueReference{Key: salt.Key, SecretName: salt.SecretName})
+		}
+	case obs.FilterTypeModify:
+		if f.ModifyFilterSpec != nil {
+			for _, op := range f.ModifyFilterSpec.Operations {
+				if op.ValueFrom != nil {
+					refs = append(refs, op.ValueFrom)
+				}
+			}
+		}
+	}
+	return refs
+}
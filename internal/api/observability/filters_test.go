@@ -0,0 +1,50 @@
+package observability_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/internal/api/observability"
+)
+
+var _ = Describe("helpers for filters", func() {
+
+	var (
+		filters = Filters{
+			{
+				Name: "redact",
+				Type: obsv1.FilterTypeRedact,
+				RedactFilterSpec: &obsv1.RedactFilterSpec{
+					Patterns: []obsv1.RedactPattern{obsv1.RedactPatternEmail},
+					Replacement: &obsv1.RedactReplacement{
+						Type: obsv1.RedactReplacementHash,
+						Salt: &obsv1.SecretReference{SecretName: "salt", Key: "salt"},
+					},
+				},
+			},
+			{
+				Name: "modify",
+				Type: obsv1.FilterTypeModify,
+				ModifyFilterSpec: &obsv1.ModifyFilterSpec{
+					Operations: []obsv1.ModifyOperation{
+						{Type: obsv1.ModifyOperationAdd, Field: ".env", ValueFrom: &obsv1.ValueReference{ConfigMapName: "cluster-info", Key: "env"}},
+						{Type: obsv1.ModifyOperationAdd, Field: ".token", ValueFrom: &obsv1.ValueReference{SecretName: "tokens", Key: "token"}},
+						{Type: obsv1.ModifyOperationAdd, Field: ".team", Value: "logging"},
+					},
+				},
+			},
+			{
+				Name: "drop",
+				Type: obsv1.FilterTypeDrop,
+			},
+		}
+	)
+
+	It("should return the names of the secrets referenced by the filters", func() {
+		Expect(filters.SecretNames()).To(ConsistOf("salt", "tokens"))
+	})
+
+	It("should return the names of the configmaps referenced by the filters", func() {
+		Expect(filters.ConfigmapNames()).To(ConsistOf("cluster-info"))
+	})
+})
@@ -14,12 +14,20 @@ type NameList interface {
 }
 
 // DeployAsDeployment evaluates the spec to determine if the collector will be deployed as a deployment.
-// Collector is not a daemonset if the only input source is an HTTP receiver
+// Collector is not a daemonset if the only input sources are receivers or kubernetes events
 // Enabled through an annotation
 func DeployAsDeployment(forwarder obs.ClusterLogForwarder) bool {
 	if _, ok := forwarder.Annotations[constants.AnnotationEnableCollectorAsDeployment]; ok {
 		inputTypes := Inputs(forwarder.Spec.Inputs).InputTypes()
-		return len(inputTypes) == 1 && inputTypes[0] == obs.InputTypeReceiver
+		if len(inputTypes) == 0 {
+			return false
+		}
+		for _, t := range inputTypes {
+			if t != obs.InputTypeReceiver && t != obs.InputTypeKubernetesEvents {
+				return false
+			}
+		}
+		return true
 	}
 	return false
 }
@@ -108,6 +108,23 @@ var _ = Describe("[internal][api][observability]", func() {
 				}
 				Expect(DeployAsDeployment(forwarder)).To(BeTrue())
 			})
+			It("should be true when there are only receiver and events inputs", func() {
+				forwarder.Spec.Inputs = []obs.InputSpec{
+					{Type: obs.InputTypeReceiver},
+					{Type: obs.InputTypeKubernetesEvents},
+				}
+				Expect(DeployAsDeployment(forwarder)).To(BeTrue())
+			})
+			It("should be true when there are only events inputs", func() {
+				forwarder.Spec.Inputs = []obs.InputSpec{
+					{Type: obs.InputTypeKubernetesEvents},
+				}
+				Expect(DeployAsDeployment(forwarder)).To(BeTrue())
+			})
+			It("should be false when there are no inputs", func() {
+				forwarder.Spec.Inputs = nil
+				Expect(DeployAsDeployment(forwarder)).To(BeFalse())
+			})
 			It("should be false when there are more then just receiver inputs", func() {
 				Expect(DeployAsDeployment(forwarder)).To(BeFalse())
 			})
@@ -1,12 +1,39 @@
 package observability
 
 import (
+	"path"
+	"regexp"
+	"strings"
+
 	"k8s.io/utils/set"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
 )
 
+// DefaultHTTPReceiverLogSource is the log source of the records of a HTTP receiver which does not spec one
+const DefaultHTTPReceiverLogSource = "http"
+
+var infraNamespaces = regexp.MustCompile(`^default$|^openshift.*$|^kube.*$`)
+
+var (
+	// HostFilesAllowedDirs are the host directories whose subdirectories may be collected by a hostFiles input
+	HostFilesAllowedDirs = []string{"/var/log"}
+
+	// HostFilesReservedDirs are the host directories collected by other inputs which may not be collected by a
+	// hostFiles input
+	HostFilesReservedDirs = []string{
+		"/var/log/audit",
+		"/var/log/containers",
+		"/var/log/journal",
+		"/var/log/kube-apiserver",
+		"/var/log/oauth-apiserver",
+		"/var/log/openshift-apiserver",
+		"/var/log/ovn",
+		"/var/log/pods",
+	}
+)
+
 var ReservedInputTypes = sets.NewString(
 	string(obs.InputTypeApplication),
 	string(obs.InputTypeAudit),
@@ -64,6 +91,13 @@ func (inputs Inputs) ConfigmapNames() []string {
 		if i.Receiver != nil && i.Receiver.TLS != nil {
 			names.Insert(ConfigmapsForTLS(obs.TLSSpec(*i.Receiver.TLS))...)
 		}
+		if i.Receiver != nil {
+			for _, r := range ReceiverAuthValueReferences(i.Receiver.Authentication) {
+				if r.SecretName == "" && r.ConfigMapName != "" {
+					names.Insert(r.ConfigMapName)
+				}
+			}
+		}
 	}
 	return names.UnsortedList()
 }
@@ -75,6 +109,13 @@ func (inputs Inputs) SecretNames() []string {
 		if i.Receiver != nil && i.Receiver.TLS != nil {
 			secrets.Insert(SecretsForTLS(obs.TLSSpec(*i.Receiver.TLS))...)
 		}
+		if i.Receiver != nil {
+			for _, r := range ReceiverAuthValueReferences(i.Receiver.Authentication) {
+				if r.SecretName != "" {
+					secrets.Insert(r.SecretName)
+				}
+			}
+		}
 	}
 	return secrets.UnsortedList()
 }
@@ -125,3 +166,99 @@ func (inputs Inputs) HasReceiverSource() bool {
 	}
 	return false
 }
+
+func (inputs Inputs) HasEventsSource() bool {
+	for _, i := range inputs {
+		if i.Type == obs.InputTypeKubernetesEvents && i.Events != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// HostFilesDirs returns the sorted list of host directories which contain the files of the hostFiles inputs
+// omitting the directories contained by another directory of the list
+func (inputs Inputs) HostFilesDirs() []string {
+	dirs := set.New[string]()
+	for _, i := range inputs {
+		if i.Type == obs.InputTypeHostFiles && i.HostFiles != nil {
+			for _, include := range i.HostFiles.Includes {
+				dirs.Insert(HostFilesDir(include))
+			}
+		}
+	}
+	var result []string
+	for _, dir := range dirs.SortedList() {
+		contained := false
+		for _, parent := range result {
+			if IsSubPath(dir, parent) {
+				contained = true
+				break
+			}
+		}
+		if !contained {
+			result = append(result, dir)
+		}
+	}
+	return result
+}
+
+// HostFilesDir returns the directory of a path up to its first glob pattern
+func HostFilesDir(pattern string) string {
+	dir := path.Dir(path.Clean(pattern))
+	if i := strings.IndexAny(dir, "*?[{"); i >= 0 {
+		dir = path.Dir(dir[:i+1])
+	}
+	return dir
+}
+
+// IsSubPath returns true if the path is the same as or is contained by the directory
+func IsSubPath(p, dir string) bool {
+	return p == dir || strings.HasPrefix(p, strings.TrimSuffix(dir, "/")+"/")
+}
+
+// KubernetesEventsLogTypes returns the log types of the records of a kubernetes events input where the events of
+// infrastructure namespaces are infrastructure logs and all others are application logs
+func KubernetesEventsLogTypes(events obs.KubernetesEvents) []obs.InputType {
+	if len(events.Namespaces) == 0 {
+		return []obs.InputType{obs.InputTypeApplication, obs.InputTypeInfrastructure}
+	}
+	types := set.New[obs.InputType]()
+	for _, ns := range events.Namespaces {
+		if infraNamespaces.MatchString(ns) {
+			types.Insert(obs.InputTypeInfrastructure)
+		} else {
+			types.Insert(obs.InputTypeApplication)
+		}
+	}
+	return types.SortedList()
+}
+
+// HTTPReceiverLogTypeAndSource returns the log type and source of the records of a HTTP receiver which does not
+// receive kubernetes API audit events
+func HTTPReceiverLogTypeAndSource(receiver obs.HTTPReceiver) (obs.InputType, string) {
+	logType, logSource := obs.InputTypeApplication, DefaultHTTPReceiverLogSource
+	if receiver.LogType != "" {
+		logType = receiver.LogType
+	}
+	if receiver.LogSource != "" {
+		logSource = receiver.LogSource
+	}
+	return logType, logSource
+}
+
+// ReceiverAuthValueReferences returns a list of the secret and configmap keys referenced by the authentication of a receiver
+func ReceiverAuthValueReferences(auth *obs.ReceiverAuthentication) (refs []*obs.ValueReference) {
+	if auth == nil {
+		return nil
+	}
+	if auth.ClientCA != nil {
+		refs = append(refs, auth.ClientCA)
+	}
+	for _, secret := range []*obs.SecretReference{auth.Token, auth.Username, auth.Password} {
+		if secret != nil {
+			refs = append(refs, &obs.ValueReference{Key: secret.Key, SecretName: secret.SecretName})
+		}
+	}
+	return refs
+}
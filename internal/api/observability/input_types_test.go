@@ -0,0 +1,33 @@
+package observability_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/internal/api/observability"
+)
+
+var _ = Describe("helpers for input types", func() {
+
+	DescribeTable("#HostFilesDir", func(pattern, exp string) {
+		Expect(HostFilesDir(pattern)).To(Equal(exp))
+	},
+		Entry("should return the directory of a file", "/var/log/nvidia/driver.log", "/var/log/nvidia"),
+		Entry("should return the directory of a file pattern", "/var/log/nvidia/*.log", "/var/log/nvidia"),
+		Entry("should return the directory preceding a directory pattern", "/var/log/nvidia/**/*.log", "/var/log/nvidia"),
+		Entry("should return the parent of a partial directory pattern", "/var/log/nv*/driver.log", "/var/log"),
+		Entry("should clean the path", "/var/log/nvidia/../gpu/./driver.log", "/var/log/gpu"),
+	)
+
+	Context("#HostFilesDirs", func() {
+		It("should return the unique directories which are not contained by another directory", func() {
+			inputs := Inputs{
+				{Type: obsv1.InputTypeHostFiles, HostFiles: &obsv1.HostFiles{Includes: []string{"/var/log/gpu/*.log", "/var/log/gpu/x/*.log", "/var/log/gpu-x/*.log"}}},
+				{Type: obsv1.InputTypeHostFiles, HostFiles: &obsv1.HostFiles{Includes: []string{"/var/log/storage/*.log", "/var/log/gpu/y.log"}}},
+				{Type: obsv1.InputTypeApplication},
+			}
+			Expect(inputs.HostFilesDirs()).To(Equal([]string{"/var/log/gpu", "/var/log/gpu-x", "/var/log/storage"}))
+		})
+	})
+})
@@ -46,13 +46,14 @@ func (outputs Outputs) ConfigmapNames() []string {
 func (outputs Outputs) NeedServiceAccountToken() bool {
 	var auths []*obsv1.BearerToken
 	for _, o := range outputs {
+		if auth, _ := AWSAuthentication(o); auth != nil && auth.Type == obsv1.CloudwatchAuthTypeIAMRole && auth.IAMRole != nil {
+			auths = append(auths, &auth.IAMRole.Token)
+		}
 		switch {
 		case o.Type == obsv1.OutputTypeLoki && o.Loki.Authentication != nil && o.Loki.Authentication.Token != nil:
 			auths = append(auths, o.Loki.Authentication.Token)
 		case o.Type == obsv1.OutputTypeLokiStack && o.LokiStack.Authentication != nil && o.LokiStack.Authentication.Token != nil:
 			auths = append(auths, o.LokiStack.Authentication.Token)
-		case o.Type == obsv1.OutputTypeCloudwatch && o.Cloudwatch != nil && o.Cloudwatch.Authentication.Type == obsv1.CloudwatchAuthTypeIAMRole:
-			auths = append(auths, &o.Cloudwatch.Authentication.IAMRole.Token)
 		case o.Type == obsv1.OutputTypeElasticsearch && o.Elasticsearch != nil && o.Elasticsearch.Authentication != nil && o.Elasticsearch.Authentication.Token != nil:
 			auths = append(auths, o.Elasticsearch.Authentication.Token)
 		case o.Type == obsv1.OutputTypeOTLP && o.OTLP.Authentication != nil && o.OTLP.Authentication.Token != nil:
@@ -68,6 +69,36 @@ func (outputs Outputs) NeedServiceAccountToken() bool {
 	return false
 }
 
+// AWSAuthentication returns the AWS authentication and region of an output that authenticates to AWS or nil
+// if the output does not use AWS credentials
+func AWSAuthentication(o obsv1.OutputSpec) (*obsv1.CloudwatchAuthentication, string) {
+	switch {
+	case o.Type == obsv1.OutputTypeCloudwatch && o.Cloudwatch != nil:
+		return o.Cloudwatch.Authentication, o.Cloudwatch.Region
+	case o.Type == obsv1.OutputTypeS3 && o.S3 != nil:
+		return o.S3.Authentication, o.S3.Region
+	case o.Type == obsv1.OutputTypeElasticsearch && o.Elasticsearch != nil && o.Elasticsearch.Authentication != nil && o.Elasticsearch.Authentication.AWS != nil:
+		return &o.Elasticsearch.Authentication.AWS.CloudwatchAuthentication, o.Elasticsearch.Authentication.AWS.Region
+	case o.Type == obsv1.OutputTypeHTTP && o.HTTP != nil && o.HTTP.Authentication != nil && o.HTTP.Authentication.AWS != nil:
+		return &o.HTTP.Authentication.AWS.CloudwatchAuthentication, o.HTTP.Authentication.AWS.Region
+	}
+	return nil, ""
+}
+
+// NeedAzureWorkloadIdentityToken returns true if any output needs to be configured to use a token associated with the
+// service account which is exchanged for an Entra ID token
+func (outputs Outputs) NeedAzureWorkloadIdentityToken() bool {
+	for _, o := range outputs {
+		if o.Type == obsv1.OutputTypeAzureLogsIngestion && o.AzureLogsIngestion != nil && o.AzureLogsIngestion.Authentication != nil {
+			auth := o.AzureLogsIngestion.Authentication
+			if auth.Type == obsv1.AzureLogsIngestionAuthTypeWorkloadIdentity && auth.Token != nil && auth.Token.From == obsv1.BearerTokenFromServiceAccount {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // SecretNames returns a unique set of unordered secret names
 func (outputs Outputs) SecretNames() []string {
 	secrets := set.New[string]()
@@ -101,6 +132,10 @@ func SecretReferencesAsValueReferences(o obsv1.OutputSpec) (configs []*obsv1.Val
 // to be nil if it was not specified for the output
 func SecretReferences(o obsv1.OutputSpec) []*obsv1.SecretReference {
 	switch o.Type {
+	case obsv1.OutputTypeAzureLogsIngestion:
+		if o.AzureLogsIngestion != nil && o.AzureLogsIngestion.Authentication != nil {
+			return azureLogsIngestionAuthKeys(o.AzureLogsIngestion.Authentication)
+		}
 	case obsv1.OutputTypeAzureMonitor:
 		if o.AzureMonitor != nil && o.AzureMonitor.Authentication != nil {
 			return []*obsv1.SecretReference{o.AzureMonitor.Authentication.SharedKey}
@@ -112,7 +147,12 @@ func SecretReferences(o obsv1.OutputSpec) []*obsv1.SecretReference {
 		}
 	case obsv1.OutputTypeElasticsearch:
 		if o.Elasticsearch != nil && o.Elasticsearch.Authentication != nil {
-			return httpAuthKeys(o.Elasticsearch.Authentication)
+			a := o.Elasticsearch.Authentication
+			keys := append(httpAuthKeys(&a.HTTPAuthentication), a.APIKey)
+			if a.AWS != nil {
+				keys = append(keys, cloudwatchAuthKeys(&a.AWS.CloudwatchAuthentication)...)
+			}
+			return keys
 		}
 	case obsv1.OutputTypeGoogleCloudLogging:
 		if o.GoogleCloudLogging != nil && o.GoogleCloudLogging.Authentication != nil {
@@ -121,7 +161,12 @@ func SecretReferences(o obsv1.OutputSpec) []*obsv1.SecretReference {
 		}
 	case obsv1.OutputTypeHTTP:
 		if o.HTTP != nil && o.HTTP.Authentication != nil {
-			return httpAuthKeys(o.HTTP.Authentication)
+			a := o.HTTP.Authentication
+			keys := httpAuthKeys(&a.HTTPAuthentication)
+			if a.AWS != nil {
+				keys = append(keys, cloudwatchAuthKeys(&a.AWS.CloudwatchAuthentication)...)
+			}
+			return keys
 		}
 	case obsv1.OutputTypeOTLP:
 		if o.OTLP != nil && o.OTLP.Authentication != nil {
@@ -130,7 +175,11 @@ func SecretReferences(o obsv1.OutputSpec) []*obsv1.SecretReference {
 	case obsv1.OutputTypeKafka:
 		if o.Kafka != nil && o.Kafka.Authentication != nil {
 			a := o.Kafka.Authentication
-			return []*obsv1.SecretReference{a.SASL.Password, a.SASL.Username}
+			keys := []*obsv1.SecretReference{a.SASL.Password, a.SASL.Username}
+			if a.SASL.OAuthBearer != nil {
+				keys = append(keys, &a.SASL.OAuthBearer.ClientID, &a.SASL.OAuthBearer.ClientSecret)
+			}
+			return keys
 		}
 	case obsv1.OutputTypeLoki:
 		if o.Loki != nil {
@@ -140,6 +189,10 @@ func SecretReferences(o obsv1.OutputSpec) []*obsv1.SecretReference {
 		if o.LokiStack != nil {
 			return lokiStackKeys(o.LokiStack.Authentication)
 		}
+	case obsv1.OutputTypeS3:
+		if o.S3 != nil && o.S3.Authentication != nil {
+			return cloudwatchAuthKeys(o.S3.Authentication)
+		}
 	case obsv1.OutputTypeSplunk:
 		if o.Splunk != nil && o.Splunk.Authentication != nil {
 			return []*obsv1.SecretReference{o.Splunk.Authentication.Token}
@@ -198,3 +251,14 @@ func cloudwatchAuthKeys(auth *obsv1.CloudwatchAuthentication) (keys []*obsv1.Sec
 	}
 	return keys
 }
+
+func azureLogsIngestionAuthKeys(auth *obsv1.AzureLogsIngestionAuthentication) []*obsv1.SecretReference {
+	keys := []*obsv1.SecretReference{&auth.TenantID, &auth.ClientID, auth.ClientSecret}
+	if auth.Token != nil && auth.Token.From == obsv1.BearerTokenFromSecret && auth.Token.Secret != nil {
+		keys = append(keys, &obsv1.SecretReference{
+			Key:        auth.Token.Secret.Key,
+			SecretName: auth.Token.Secret.Name,
+		})
+	}
+	return keys
+}
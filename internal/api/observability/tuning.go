@@ -12,6 +12,10 @@ type Tuning struct {
 func NewTuning(spec obs.OutputSpec) Tuning {
 	t := Tuning{}
 	switch spec.Type {
+	case obs.OutputTypeAzureLogsIngestion:
+		if spec.AzureLogsIngestion != nil && spec.AzureLogsIngestion.Tuning != nil {
+			t.BaseOutputTuningSpec = *spec.AzureLogsIngestion.Tuning
+		}
 	case obs.OutputTypeAzureMonitor:
 		if spec.AzureMonitor != nil && spec.AzureMonitor.Tuning != nil {
 			t.BaseOutputTuningSpec = *spec.AzureMonitor.Tuning
@@ -56,6 +60,11 @@ func NewTuning(spec obs.OutputSpec) Tuning {
 			t.BaseOutputTuningSpec = spec.LokiStack.Tuning.BaseOutputTuningSpec
 			t.Compression = spec.LokiStack.Tuning.Compression
 		}
+	case obs.OutputTypeS3:
+		if spec.S3 != nil && spec.S3.Tuning != nil {
+			t.BaseOutputTuningSpec = spec.S3.Tuning.BaseOutputTuningSpec
+			t.Compression = spec.S3.Tuning.Compression
+		}
 	case obs.OutputTypeSplunk:
 		if spec.Splunk != nil && spec.Splunk.Tuning != nil {
 			t.BaseOutputTuningSpec = spec.Splunk.Tuning.BaseOutputTuningSpec
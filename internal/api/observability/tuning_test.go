@@ -37,6 +37,12 @@ var _ = Describe("Output", func() {
 		}
 		Expect(tuningSpec.Compression).To(Equal(expCompression))
 	},
+		Entry("with AzureLogsIngestion", obs.OutputSpec{
+			Type: obs.OutputTypeAzureLogsIngestion,
+			AzureLogsIngestion: &obs.AzureLogsIngestion{
+				Tuning: baseSpec,
+			},
+		}, baseSpec, ""),
 		Entry("with AzureMonitor", obs.OutputSpec{
 			Type: obs.OutputTypeAzureMonitor,
 			AzureMonitor: &obs.AzureMonitor{
@@ -60,6 +66,15 @@ var _ = Describe("Output", func() {
 				},
 			},
 		}, baseSpec, compression),
+		Entry("with S3", obs.OutputSpec{
+			Type: obs.OutputTypeS3,
+			S3: &obs.S3{
+				Tuning: &obs.S3TuningSpec{
+					BaseOutputTuningSpec: *baseSpec,
+					Compression:          compression,
+				},
+			},
+		}, baseSpec, compression),
 		Entry("with Elasticsearch", obs.OutputSpec{
 			Type: obs.OutputTypeElasticsearch,
 			Elasticsearch: &obs.Elasticsearch{
@@ -10,22 +10,25 @@ import (
 	v1 "k8s.io/api/core/v1"
 )
 
-// Add volumes and env vars if output type is cloudwatch and role is found in the secret
-func addWebIdentityForCloudwatch(collector *v1.Container, forwarderSpec obs.ClusterLogForwarderSpec, secrets observability.Secrets) {
+// Add volumes and env vars if an output authenticates to AWS and role is found in the secret
+func addWebIdentityForAWS(collector *v1.Container, forwarderSpec obs.ClusterLogForwarderSpec, secrets observability.Secrets) {
 	if secrets == nil {
 		return
 	}
 	for _, o := range forwarderSpec.Outputs {
-		if o.Type == obs.OutputTypeCloudwatch && o.Cloudwatch.Authentication != nil && o.Cloudwatch.Authentication.Type == obs.CloudwatchAuthTypeIAMRole {
+		auth, region := observability.AWSAuthentication(o)
+		if auth != nil && auth.Type == obs.CloudwatchAuthTypeIAMRole {
 
-			if roleARN := cloudwatch.ParseRoleArn(o.Cloudwatch.Authentication, secrets); roleARN != "" {
+			if roleARN := cloudwatch.ParseRoleArn(auth, secrets); roleARN != "" {
 				tokenPath := common.ServiceAccountBasePath(constants.TokenKey)
-				if o.Cloudwatch.Authentication.IAMRole.Token.From == obs.BearerTokenFromSecret {
-					secret := o.Cloudwatch.Authentication.IAMRole.Token.Secret
+				if auth.IAMRole.Token.From == obs.BearerTokenFromSecret {
+					secret := auth.IAMRole.Token.Secret
 					tokenPath = common.SecretPath(secret.Name, secret.Key)
 				}
 
-				AddWebIdentityTokenEnvVars(collector, o.Cloudwatch.Region, roleARN, tokenPath)
+				AddWebIdentityTokenEnvVars(collector, region, roleARN, tokenPath)
+				// the collector supports a single web identity which is enforced by validation
+				return
 			}
 		}
 	}
@@ -35,7 +38,7 @@ func addWebIdentityForCloudwatch(collector *v1.Container, forwarderSpec obs.Clus
 func AddWebIdentityTokenEnvVars(collector *v1.Container, region, roleARN, tokenPath string) {
 
 	// Necessary for vector to use sts
-	log.V(3).Info("Adding env vars for vector sts Cloudwatch")
+	log.V(3).Info("Adding env vars for vector sts AWS")
 	collector.Env = append(collector.Env,
 		v1.EnvVar{
 			Name:  constants.AWSRegionEnvVarKey,
@@ -0,0 +1,50 @@
+package collector
+
+import (
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/collector/common"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	v1 "k8s.io/api/core/v1"
+)
+
+// Add env vars for the Entra ID environment if output type is azureLogsIngestion
+func addWorkloadIdentityForAzure(collector *v1.Container, forwarderSpec obs.ClusterLogForwarderSpec) {
+	found := false
+	authorityHost, tokenPath := "", ""
+	for _, o := range forwarderSpec.Outputs {
+		if o.Type != obs.OutputTypeAzureLogsIngestion || o.AzureLogsIngestion == nil || o.AzureLogsIngestion.Authentication == nil {
+			continue
+		}
+		// the collector supports a single Entra ID environment which is enforced by validation
+		auth := o.AzureLogsIngestion.Authentication
+		found = true
+		authorityHost = auth.AuthorityHost
+		if tokenPath == "" && auth.Type == obs.AzureLogsIngestionAuthTypeWorkloadIdentity && auth.Token != nil {
+			tokenPath = common.AzureServiceAccountBasePath(constants.TokenKey)
+			if auth.Token.From == obs.BearerTokenFromSecret && auth.Token.Secret != nil {
+				tokenPath = common.SecretPath(auth.Token.Secret.Name, auth.Token.Secret.Key)
+			}
+		}
+	}
+	if found {
+		AddAzureIdentityEnvVars(collector, authorityHost, tokenPath)
+	}
+}
+
+// AddAzureIdentityEnvVars appends the Entra ID env vars for the authority host and the federated token when they are set
+func AddAzureIdentityEnvVars(collector *v1.Container, authorityHost, tokenPath string) {
+	log.V(3).Info("Adding env vars for vector Azure identity")
+	if authorityHost != "" {
+		collector.Env = append(collector.Env, v1.EnvVar{
+			Name:  constants.AzureAuthorityHostEnvVarKey,
+			Value: authorityHost,
+		})
+	}
+	if tokenPath != "" {
+		collector.Env = append(collector.Env, v1.EnvVar{
+			Name:  constants.AzureFederatedTokenFileEnvVarKey,
+			Value: tokenPath,
+		})
+	}
+}
@@ -28,6 +28,7 @@ const (
 	metricsVolumeName               = "metrics"
 	metricsVolumePath               = "/etc/collector/metrics"
 	saTokenVolumeName               = "sa-token"
+	azureSATokenVolumeName          = "azure-sa-token"
 	saTokenExpirationSecs           = 3600 //1 hour
 	sourcePodsName                  = "varlogpods"
 	sourcePodsPath                  = "/var/log/pods"
@@ -43,6 +44,7 @@ const (
 	sourceOpenshiftAPIServerPath    = "/var/log/openshift-apiserver"
 	sourceKubeAPIServerName         = "varlogkubeapiserver"
 	sourceKubeAPIServerPath         = "/var/log/kube-apiserver"
+	hostFilesVolumePrefix           = "hostfiles"
 	tmpVolumeName                   = "tmp"
 	tmpPath                         = "/tmp"
 )
@@ -116,7 +118,12 @@ func (f *Factory) NewDaemonSet(namespace, name string, trustedCABundle *v1.Confi
 
 func (f *Factory) NewDeployment(namespace, name string, trustedCABundle *v1.ConfigMap, tlsProfileSpec configv1.TLSProfileSpec) *apps.Deployment {
 	podSpec := f.NewPodSpec(trustedCABundle, f.ForwarderSpec, f.ClusterID, tlsProfileSpec, namespace)
-	dpl := factory.NewDeployment(namespace, name, constants.CollectorName, constants.VectorName, 2, *podSpec, f.CommonLabelInitializer, f.PodLabelVisitor)
+	replicas := int32(2)
+	if internalobs.Inputs(f.ForwarderSpec.Inputs).HasEventsSource() {
+		// events are collected from the API server by a single instance to avoid forwarding duplicates
+		replicas = 1
+	}
+	dpl := factory.NewDeployment(namespace, name, constants.CollectorName, constants.VectorName, replicas, *podSpec, f.CommonLabelInitializer, f.PodLabelVisitor)
 	dpl.Spec.Template.Annotations[constants.AnnotationSecretHash] = f.Secrets.Hash64a()
 	return dpl
 }
@@ -145,12 +152,18 @@ func (f *Factory) NewPodSpec(trustedCABundle *v1.ConfigMap, spec obs.ClusterLogF
 			v1.Volume{Name: sourceOpenshiftAPIServerName, VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: sourceOpenshiftAPIServerPath}}},
 			v1.Volume{Name: sourceKubeAPIServerName, VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: sourceKubeAPIServerPath}}},
 		)
+		for i, dir := range internalobs.Inputs(spec.Inputs).HostFilesDirs() {
+			podSpec.Volumes = append(podSpec.Volumes, v1.Volume{Name: hostFilesVolumeName(i), VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: dir}}})
+		}
 	}
 
 	secretVolumes := AddSecretVolumes(podSpec, f.Secrets)
 	configmapVolumes := AddConfigmapVolumes(podSpec, f.ConfigMaps)
 	if internalobs.Outputs(spec.Outputs).NeedServiceAccountToken() {
-		AddServiceAccountProjectedVolume(podSpec, defaultAudience)
+		AddServiceAccountProjectedVolume(podSpec, saTokenVolumeName, defaultAudience)
+	}
+	if internalobs.Outputs(spec.Outputs).NeedAzureWorkloadIdentityToken() {
+		AddServiceAccountProjectedVolume(podSpec, azureSATokenVolumeName, constants.AzureWorkloadIdentityAudience)
 	}
 
 	collector := f.NewCollectorContainer(spec.Inputs, spec.Outputs, secretVolumes, configmapVolumes, clusterID)
@@ -158,7 +171,8 @@ func (f *Factory) NewPodSpec(trustedCABundle *v1.ConfigMap, spec obs.ClusterLogF
 	addTrustedCABundle(collector, podSpec, trustedCABundle)
 
 	f.Visit(collector, podSpec, f.ResourceNames, namespace, f.LogLevel)
-	addWebIdentityForCloudwatch(collector, spec, f.Secrets)
+	addWebIdentityForAWS(collector, spec, f.Secrets)
+	addWorkloadIdentityForAzure(collector, spec)
 
 	podSpec.Containers = []v1.Container{
 		*collector,
@@ -212,6 +226,9 @@ func (f *Factory) NewCollectorContainer(inputs internalobs.Inputs, outputs inter
 		if inputs.HasAuditSource(obs.AuditSourceOVN) {
 			collector.VolumeMounts = append(collector.VolumeMounts, v1.VolumeMount{Name: sourceAuditOVNName, ReadOnly: true, MountPath: sourceOVNPath})
 		}
+		for i, dir := range inputs.HostFilesDirs() {
+			collector.VolumeMounts = append(collector.VolumeMounts, v1.VolumeMount{Name: hostFilesVolumeName(i), ReadOnly: true, MountPath: dir})
+		}
 		AddSecurityContextTo(collector)
 	}
 
@@ -226,10 +243,20 @@ func (f *Factory) NewCollectorContainer(inputs internalobs.Inputs, outputs inter
 			return constants.ServiceAccountSecretPath
 		})
 	}
+	if outputs.NeedAzureWorkloadIdentityToken() {
+		AddVolumeMounts(collector, []string{azureSATokenVolumeName}, func(name string) string {
+			return constants.AzureServiceAccountSecretPath
+		})
+	}
 
 	return collector
 }
 
+// hostFilesVolumeName is the name of the volume of a host directory of the hostFiles inputs
+func hostFilesVolumeName(index int) string {
+	return fmt.Sprintf("%s-%d", hostFilesVolumePrefix, index)
+}
+
 func sanitizeVolumeName(input string) string {
 	return strings.ReplaceAll(input, ".", "")
 }
@@ -289,11 +316,11 @@ func AddConfigmapVolumes(podSpec *v1.PodSpec, configMaps internalobs.ConfigMaps)
 	return results
 }
 
-// AddServiceAccountProjectedVolume adds ServiceAccountTokenProjection to the podspec and returns the named sa volume
-func AddServiceAccountProjectedVolume(podSpec *v1.PodSpec, audience string) {
+// AddServiceAccountProjectedVolume adds a ServiceAccountTokenProjection for the audience to the podspec as the named volume
+func AddServiceAccountProjectedVolume(podSpec *v1.PodSpec, name, audience string) {
 	podSpec.Volumes = append(podSpec.Volumes,
 		v1.Volume{
-			Name: saTokenVolumeName,
+			Name: name,
 			VolumeSource: v1.VolumeSource{
 				Projected: &v1.ProjectedVolumeSource{
 					Sources: []v1.VolumeProjection{
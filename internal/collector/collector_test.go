@@ -129,6 +129,23 @@ var _ = Describe("Factory#Daemonset", func() {
 							ReadOnly:  true,
 							MountPath: constants.ServiceAccountSecretPath}))
 				})
+				It("should mount only the host directories of the hostFiles inputs read-only", func() {
+					podSpec = *factory.NewPodSpec(nil, obs.ClusterLogForwarderSpec{
+						Inputs: []obs.InputSpec{
+							{
+								Name: "gpu",
+								Type: obs.InputTypeHostFiles,
+								HostFiles: &obs.HostFiles{
+									Includes: []string{"/var/log/nvidia/*.log", "/var/log/nvidia/x/*.log"},
+								},
+							},
+						},
+					}, "1234", tls.GetClusterTLSProfileSpec(nil), constants.OpenshiftNS)
+					collector = podSpec.Containers[0]
+					Expect(podSpec.Volumes).To(IncludeVolume(v1.Volume{Name: "hostfiles-0", VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/var/log/nvidia"}}}))
+					Expect(podSpec.Volumes).ToNot(IncludeVolume(v1.Volume{Name: "hostfiles-1", VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/var/log/nvidia/x"}}}))
+					Expect(collector.VolumeMounts).To(IncludeVolumeMount(v1.VolumeMount{Name: "hostfiles-0", ReadOnly: true, MountPath: "/var/log/nvidia"}))
+				})
 			})
 		})
 
@@ -598,6 +615,17 @@ var _ = Describe("Factory#Deployment", func() {
 			Expect(actDpl.Spec.Template.Annotations).To(HaveKey(constants.AnnotationSecretHash))
 			Expect(actDpl.Spec.Template.Annotations).To(HaveKey(targetAnnotation))
 		})
+		It("should deploy multiple replicas by default", func() {
+			actDpl := *factory.NewDeployment(constants.OpenshiftNS, "test", nil, tls.GetClusterTLSProfileSpec(nil))
+			Expect(*actDpl.Spec.Replicas).To(BeEquivalentTo(2))
+		})
+		It("should deploy a single replica when collecting events", func() {
+			factory.ForwarderSpec.Inputs = []obs.InputSpec{
+				{Name: "myevents", Type: obs.InputTypeKubernetesEvents, Events: &obs.KubernetesEvents{}},
+			}
+			actDpl := *factory.NewDeployment(constants.OpenshiftNS, "test", nil, tls.GetClusterTLSProfileSpec(nil))
+			Expect(*actDpl.Spec.Replicas).To(BeEquivalentTo(1))
+		})
 	})
 
 })
@@ -736,6 +764,67 @@ var _ = Describe("Factory#NewPodSpec Add Cloudwatch STS Resources", func() {
 			}))
 		})
 
+		It("should add the AWS web identity env vars in the container for an s3 output", func() {
+			s3Outputs := []obs.OutputSpec{
+				{
+					Type: obs.OutputTypeS3,
+					Name: outputs[0].Name,
+					S3: &obs.S3{
+						Region:         "us-west-42",
+						Bucket:         "my-archive",
+						Authentication: outputs[0].Cloudwatch.Authentication,
+					},
+				},
+			}
+			podSpec := *factory.NewPodSpec(nil, obs.ClusterLogForwarderSpec{
+				Outputs:   s3Outputs,
+				Pipelines: pipelines,
+			}, "1234", tls.GetClusterTLSProfileSpec(nil), constants.OpenshiftNS)
+			collector := podSpec.Containers[0]
+
+			Expect(collector.Env).To(IncludeEnvVar(v1.EnvVar{
+				Name:  constants.AWSRegionEnvVarKey,
+				Value: s3Outputs[0].S3.Region,
+			}))
+			Expect(collector.Env).To(IncludeEnvVar(v1.EnvVar{
+				Name:  constants.AWSRoleArnEnvVarKey,
+				Value: roleArn,
+			}))
+		})
+
+		It("should add the AWS web identity env vars in the container for an elasticsearch output signing requests with AWS SigV4", func() {
+			esOutputs := []obs.OutputSpec{
+				{
+					Type: obs.OutputTypeElasticsearch,
+					Name: outputs[0].Name,
+					Elasticsearch: &obs.Elasticsearch{
+						URLSpec: obs.URLSpec{URL: "https://search-logs.us-west-42.es.amazonaws.com"},
+						Index:   "{.log_type||\"none\"}",
+						Authentication: &obs.ElasticsearchAuthentication{
+							AWS: &obs.AWSAuthentication{
+								CloudwatchAuthentication: *outputs[0].Cloudwatch.Authentication,
+								Region:                   "us-west-42",
+							},
+						},
+					},
+				},
+			}
+			podSpec := *factory.NewPodSpec(nil, obs.ClusterLogForwarderSpec{
+				Outputs:   esOutputs,
+				Pipelines: pipelines,
+			}, "1234", tls.GetClusterTLSProfileSpec(nil), constants.OpenshiftNS)
+			collector := podSpec.Containers[0]
+
+			Expect(collector.Env).To(IncludeEnvVar(v1.EnvVar{
+				Name:  constants.AWSRegionEnvVarKey,
+				Value: esOutputs[0].Elasticsearch.Authentication.AWS.Region,
+			}))
+			Expect(collector.Env).To(IncludeEnvVar(v1.EnvVar{
+				Name:  constants.AWSRoleArnEnvVarKey,
+				Value: roleArn,
+			}))
+		})
+
 		It("should mount the secret for the bearer token when spec'd", func() {
 			outputs[0].Cloudwatch.Authentication.IAMRole.Token = bearerToken
 			podSpec := *factory.NewPodSpec(nil, obs.ClusterLogForwarderSpec{
@@ -760,3 +849,119 @@ var _ = Describe("Factory#NewPodSpec Add Cloudwatch STS Resources", func() {
 		})
 	})
 })
+
+var _ = Describe("Factory#NewPodSpec Add Azure Workload Identity Resources", func() {
+
+	var (
+		factory   *Factory
+		pipelines = []obs.PipelineSpec{
+			{
+				Name:       "ali-forward",
+				InputRefs:  []string{string(obs.InputTypeApplication)},
+				OutputRefs: []string{"ali"},
+			},
+		}
+		initOutputs = func() []obs.OutputSpec {
+			return []obs.OutputSpec{
+				{
+					Type: obs.OutputTypeAzureLogsIngestion,
+					Name: "ali",
+					AzureLogsIngestion: &obs.AzureLogsIngestion{
+						URLSpec:        obs.URLSpec{URL: "https://my-dce.eastus-1.ingest.monitor.azure.com"},
+						DCRImmutableID: "dcr-00000000000000000000000000000000",
+						StreamName:     "Custom-MyTable_CL",
+						Authentication: &obs.AzureLogsIngestionAuthentication{
+							Type:     obs.AzureLogsIngestionAuthTypeWorkloadIdentity,
+							TenantID: obs.SecretReference{Key: "tenant_id", SecretName: "ali"},
+							ClientID: obs.SecretReference{Key: "client_id", SecretName: "ali"},
+							Token: &obs.BearerToken{
+								From: obs.BearerTokenFromServiceAccount,
+							},
+						},
+					},
+				},
+			}
+		}
+		newPodSpec = func(outputs []obs.OutputSpec) v1.PodSpec {
+			return *factory.NewPodSpec(nil, obs.ClusterLogForwarderSpec{
+				Outputs:   outputs,
+				Pipelines: pipelines,
+			}, "1234", tls.GetClusterTLSProfileSpec(nil), constants.OpenshiftNS)
+		}
+	)
+	BeforeEach(func() {
+		factory = &Factory{
+			ImageName:     constants.VectorName,
+			Visit:         vector.CollectorVisitor,
+			Secrets:       map[string]*v1.Secret{},
+			ResourceNames: coreFactory.ResourceNames(*obsruntime.NewClusterLogForwarder(constants.OpenshiftNS, constants.SingletonName, runtime.Initialize)),
+		}
+	})
+
+	It("should project and mount a service account token for the Entra ID audience", func() {
+		podSpec := newPodSpec(initOutputs())
+		collector := podSpec.Containers[0]
+		Expect(podSpec.Volumes).To(IncludeVolume(v1.Volume{
+			Name: azureSATokenVolumeName,
+			VolumeSource: v1.VolumeSource{
+				Projected: &v1.ProjectedVolumeSource{
+					Sources: []v1.VolumeProjection{
+						{
+							ServiceAccountToken: &v1.ServiceAccountTokenProjection{
+								Audience:          constants.AzureWorkloadIdentityAudience,
+								ExpirationSeconds: utils.GetPtr[int64](saTokenExpirationSecs),
+								Path:              constants.TokenKey,
+							},
+						},
+					},
+				},
+			},
+		}))
+		Expect(collector.VolumeMounts).To(IncludeVolumeMount(v1.VolumeMount{
+			Name:      azureSATokenVolumeName,
+			ReadOnly:  true,
+			MountPath: constants.AzureServiceAccountSecretPath,
+		}))
+		Expect(collector.Env).To(IncludeEnvVar(v1.EnvVar{
+			Name:  constants.AzureFederatedTokenFileEnvVarKey,
+			Value: path.Join(constants.AzureServiceAccountSecretPath, constants.TokenKey),
+		}))
+		Expect(podSpec.Volumes).ToNot(ContainElement(HaveField("Name", saTokenVolumeName)))
+	})
+
+	It("should use the token from the secret and the authority host when spec'd", func() {
+		outputs := initOutputs()
+		auth := outputs[0].AzureLogsIngestion.Authentication
+		auth.AuthorityHost = "https://login.microsoftonline.us"
+		auth.Token = &obs.BearerToken{
+			From: obs.BearerTokenFromSecret,
+			Secret: &obs.BearerTokenSecretKey{
+				Key:  constants.TokenKey,
+				Name: "mysecret",
+			},
+		}
+		podSpec := newPodSpec(outputs)
+		collector := podSpec.Containers[0]
+		Expect(podSpec.Volumes).ToNot(ContainElement(HaveField("Name", azureSATokenVolumeName)))
+		Expect(collector.Env).To(IncludeEnvVar(v1.EnvVar{
+			Name:  constants.AzureFederatedTokenFileEnvVarKey,
+			Value: path.Join(constants.CollectorSecretsDir, "mysecret", constants.TokenKey),
+		}))
+		Expect(collector.Env).To(IncludeEnvVar(v1.EnvVar{
+			Name:  constants.AzureAuthorityHostEnvVarKey,
+			Value: auth.AuthorityHost,
+		}))
+	})
+
+	It("should not add the federated token for client secret authentication", func() {
+		outputs := initOutputs()
+		auth := outputs[0].AzureLogsIngestion.Authentication
+		auth.Type = obs.AzureLogsIngestionAuthTypeClientSecret
+		auth.Token = nil
+		auth.ClientSecret = &obs.SecretReference{Key: "client_secret", SecretName: "ali"}
+		podSpec := newPodSpec(outputs)
+		collector := podSpec.Containers[0]
+		Expect(podSpec.Volumes).ToNot(ContainElement(HaveField("Name", azureSATokenVolumeName)))
+		Expect(collector.Env).ToNot(ContainElement(HaveField("Name", constants.AzureFederatedTokenFileEnvVarKey)))
+	})
+})
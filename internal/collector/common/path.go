@@ -29,3 +29,8 @@ func ConfigMapBasePath(name string) string {
 func ServiceAccountBasePath(name string) string {
 	return filepath.Join(constants.ServiceAccountSecretPath, name)
 }
+
+// AzureServiceAccountBasePath is the base path for the serviceaccount token projection exchanged for an Entra ID token
+func AzureServiceAccountBasePath(name string) string {
+	return filepath.Join(constants.AzureServiceAccountSecretPath, name)
+}
@@ -8,7 +8,9 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	"github.com/openshift/cluster-logging-operator/internal/runtime/service"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/set"
 	kubernetes "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -21,11 +23,9 @@ func (f *Factory) ReconcileInputServices(k8sClient kubernetes.Client, k8sReader
 	}
 
 	for _, input := range f.ForwarderSpec.Inputs {
-		var listenPort int32
 		serviceName := f.ResourceNames.GenerateInputServiceName(input.Name)
 		if input.Receiver != nil {
-			listenPort = input.Receiver.Port
-			if err := network.ReconcileInputService(k8sClient, namespace, serviceName, f.ResourceNames.CommonName, serviceName, listenPort, listenPort, input.Receiver.Type, owner, visitors); err != nil {
+			if err := network.ReconcileInputService(k8sClient, namespace, serviceName, f.ResourceNames.CommonName, serviceName, receiverServicePorts(*input.Receiver), input.Receiver.Type, owner, visitors); err != nil {
 				return err
 			}
 		}
@@ -33,6 +33,43 @@ func (f *Factory) ReconcileInputServices(k8sClient kubernetes.Client, k8sReader
 	return nil
 }
 
+// receiverServicePorts returns the ports on which a receiver listens. Ports are named when a receiver listens on more than one
+func receiverServicePorts(receiver obs.ReceiverSpec) []corev1.ServicePort {
+	newPort := func(name string, port int32, protocol corev1.Protocol) corev1.ServicePort {
+		return corev1.ServicePort{
+			Name: name,
+			Port: port,
+			TargetPort: intstr.IntOrString{
+				Type:   intstr.Int,
+				IntVal: port,
+			},
+			Protocol: protocol,
+		}
+	}
+	switch receiver.Type {
+	case obs.ReceiverTypeSyslog:
+		if receiver.Syslog != nil {
+			switch receiver.Syslog.Protocol {
+			case obs.SyslogReceiverProtocolUDP:
+				return []corev1.ServicePort{newPort("", receiver.Port, corev1.ProtocolUDP)}
+			case obs.SyslogReceiverProtocolBoth:
+				return []corev1.ServicePort{
+					newPort("tcp", receiver.Port, corev1.ProtocolTCP),
+					newPort("udp", receiver.Port, corev1.ProtocolUDP),
+				}
+			}
+		}
+	case obs.ReceiverTypeOTLP:
+		if receiver.OTLP != nil && receiver.OTLP.GRPCPort != nil {
+			return []corev1.ServicePort{
+				newPort("http", receiver.Port, corev1.ProtocolTCP),
+				newPort("grpc", *receiver.OTLP.GRPCPort, corev1.ProtocolTCP),
+			}
+		}
+	}
+	return []corev1.ServicePort{newPort("", receiver.Port, corev1.ProtocolTCP)}
+}
+
 // RemoveOrphanedInputServices removes receiver input services not owned by the given owner
 func RemoveOrphanedInputServices(client kubernetes.Client, reader kubernetes.Reader, namespace string, spec obs.ClusterLogForwarderSpec, resourceNames factory.ForwarderResourceNames, currOwner metav1.OwnerReference, removeAllServices bool) error {
 
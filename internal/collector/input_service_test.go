@@ -0,0 +1,41 @@
+package collector
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("#receiverServicePorts", func() {
+
+	var port = func(name string, num int32, protocol corev1.Protocol) corev1.ServicePort {
+		return corev1.ServicePort{
+			Name:       name,
+			Port:       num,
+			TargetPort: intstr.FromInt32(num),
+			Protocol:   protocol,
+		}
+	}
+
+	DescribeTable("should expose the ports on which a receiver listens", func(receiver obs.ReceiverSpec, exp []corev1.ServicePort) {
+		receiver.Port = 8443
+		Expect(receiverServicePorts(receiver)).To(Equal(exp))
+	},
+		Entry("for a http receiver", obs.ReceiverSpec{Type: obs.ReceiverTypeHTTP},
+			[]corev1.ServicePort{port("", 8443, corev1.ProtocolTCP)}),
+		Entry("for a syslog receiver listening for udp", obs.ReceiverSpec{Type: obs.ReceiverTypeSyslog, Syslog: &obs.SyslogReceiver{Protocol: obs.SyslogReceiverProtocolUDP}},
+			[]corev1.ServicePort{port("", 8443, corev1.ProtocolUDP)}),
+		Entry("for a syslog receiver listening for tcp and udp", obs.ReceiverSpec{Type: obs.ReceiverTypeSyslog, Syslog: &obs.SyslogReceiver{Protocol: obs.SyslogReceiverProtocolBoth}},
+			[]corev1.ServicePort{port("tcp", 8443, corev1.ProtocolTCP), port("udp", 8443, corev1.ProtocolUDP)}),
+		Entry("for a forward receiver", obs.ReceiverSpec{Type: obs.ReceiverTypeForward},
+			[]corev1.ServicePort{port("", 8443, corev1.ProtocolTCP)}),
+		Entry("for an otlp receiver", obs.ReceiverSpec{Type: obs.ReceiverTypeOTLP},
+			[]corev1.ServicePort{port("", 8443, corev1.ProtocolTCP)}),
+		Entry("for an otlp receiver listening for grpc", obs.ReceiverSpec{Type: obs.ReceiverTypeOTLP, OTLP: &obs.OTLPReceiver{GRPCPort: utils.GetPtr(int32(4317))}},
+			[]corev1.ServicePort{port("http", 8443, corev1.ProtocolTCP), port("grpc", 4317, corev1.ProtocolTCP)}),
+	)
+})
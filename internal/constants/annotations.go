@@ -17,4 +17,12 @@ const (
 	AnnotationOtlpOutputTechPreview = "observability.openshift.io/tech-preview-otlp-output"
 
 	AnnotationSecretHash = "observability.openshift.io/secret-hash"
+
+	// AnnotationCollect is the pod annotation to opt a pod in ("true") or out ("false") of container log
+	// collection for application inputs which honor pod annotations.
+	AnnotationCollect = "observability.openshift.io/collect"
+
+	// AnnotationExcludeContainers is the pod annotation listing the comma-separated names of containers
+	// whose logs are not collected by application inputs which honor pod annotations.
+	AnnotationExcludeContainers = "observability.openshift.io/exclude-containers"
 )
@@ -31,6 +31,12 @@ const (
 	AWSRoleSessionEnvVarKey      = "AWS_ROLE_SESSION_NAME"
 	AWSWebIdentityTokenEnvVarKey = "AWS_WEB_IDENTITY_TOKEN_FILE" //nolint:gosec
 
+	AzureWorkloadIdentityAudience    = "api://AzureADTokenExchange" // audience of the token exchanged for an Entra ID token
+	AzureFederatedTokenFileEnvVarKey = "AZURE_FEDERATED_TOKEN_FILE" //nolint:gosec
+	AzureAuthorityHostEnvVarKey      = "AZURE_AUTHORITY_HOST"
+	//AzureServiceAccountSecretPath is the path to find the projected serviceAccount token exchanged for an Entra ID token
+	AzureServiceAccountSecretPath = "/var/run/ocp-collector/azure/serviceaccount"
+
 	SplunkHECTokenKey = `hecToken`
 
 	TokenKey          = "token"
@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 )
 
 const (
@@ -128,9 +129,10 @@ func RemoveStaleWorkload(k8Client client.Client, forwarder *obsv1.ClusterLogForw
 	return remove(k8Client, forwarder.Namespace, forwarder.Name)
 }
 
-func MapSecrets(k8Client client.Client, namespace string, inputs internalobs.Inputs, outputs internalobs.Outputs) (secretMap map[string]*corev1.Secret, err error) {
+func MapSecrets(k8Client client.Client, namespace string, inputs internalobs.Inputs, outputs internalobs.Outputs, filters internalobs.Filters) (secretMap map[string]*corev1.Secret, err error) {
 	names := set.New(inputs.SecretNames()...)
 	names.Insert(outputs.SecretNames()...)
+	names.Insert(filters.SecretNames()...)
 	log.WithName(loggerName).V(4).Info("MapSecrets", "names", names.SortedList())
 	secretMap = map[string]*corev1.Secret{}
 	var secrets []*corev1.Secret
@@ -144,9 +146,10 @@ func MapSecrets(k8Client client.Client, namespace string, inputs internalobs.Inp
 	return secretMap, nil
 }
 
-func MapConfigMaps(k8Client client.Client, namespace string, inputs internalobs.Inputs, outputs internalobs.Outputs) (configMaps map[string]*corev1.ConfigMap, err error) {
+func MapConfigMaps(k8Client client.Client, namespace string, inputs internalobs.Inputs, outputs internalobs.Outputs, filters internalobs.Filters) (configMaps map[string]*corev1.ConfigMap, err error) {
 	names := set.New(inputs.ConfigmapNames()...)
 	names.Insert(outputs.ConfigmapNames()...)
+	names.Insert(filters.ConfigmapNames()...)
 	log.WithName(loggerName).V(4).Info("MapConfigMaps", "names", names.SortedList())
 	configMaps = map[string]*corev1.ConfigMap{}
 	var configs []*corev1.ConfigMap
@@ -168,7 +171,7 @@ func (r *ClusterLogForwarderReconciler) Initialize() (err error) {
 	migrated := initialize.ClusterLogForwarder(*r.Forwarder, r.AdditionalContext)
 	r.Forwarder = &migrated
 
-	if r.Secrets, err = MapSecrets(r.Client, r.Forwarder.Namespace, r.Forwarder.Spec.Inputs, r.Forwarder.Spec.Outputs); err != nil {
+	if r.Secrets, err = MapSecrets(r.Client, r.Forwarder.Namespace, r.Forwarder.Spec.Inputs, r.Forwarder.Spec.Outputs, r.Forwarder.Spec.Filters); err != nil {
 		return err
 	}
 
@@ -178,16 +181,27 @@ func (r *ClusterLogForwarderReconciler) Initialize() (err error) {
 		}
 	}
 
-	if r.ConfigMaps, err = MapConfigMaps(r.Client, r.Forwarder.Namespace, r.Forwarder.Spec.Inputs, r.Forwarder.Spec.Outputs); err != nil {
+	if r.ConfigMaps, err = MapConfigMaps(r.Client, r.Forwarder.Namespace, r.Forwarder.Spec.Inputs, r.Forwarder.Spec.Outputs, r.Forwarder.Spec.Filters); err != nil {
 		return err
 	}
 	return nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
+// Secrets and ConfigMaps are watched so changes to referenced credentials (e.g. rotation) trigger a reconciliation
+// of every ClusterLogForwarder in the namespace that references them
 func (r *ClusterLogForwarderReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	indexer := mgr.GetFieldIndexer()
+	if err := indexer.IndexField(context.Background(), &obsv1.ClusterLogForwarder{}, SecretIndexKey, SecretNamesIndexer); err != nil {
+		return err
+	}
+	if err := indexer.IndexField(context.Background(), &obsv1.ClusterLogForwarder{}, ConfigMapIndexKey, ConfigMapNamesIndexer); err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&obsv1.ClusterLogForwarder{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(MapToReferencingForwarders(mgr.GetClient(), SecretIndexKey))).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(MapToReferencingForwarders(mgr.GetClient(), ConfigMapIndexKey))).
 		Complete(r)
 }
 
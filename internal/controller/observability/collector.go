@@ -42,7 +42,7 @@ func ReconcileCollector(context internalcontext.ForwarderContext, pollInterval,
 		options = context.AdditionalContext
 	}
 
-	if internalobs.Outputs(context.Forwarder.Spec.Outputs).NeedServiceAccountToken() {
+	if internalobs.Outputs(context.Forwarder.Spec.Outputs).NeedServiceAccountToken() || internalobs.Inputs(context.Forwarder.Spec.Inputs).HasEventsSource() {
 		// temporarily create SA token until collector is capable of dynamically reloading a projected serviceaccount token
 		var sa *corev1.ServiceAccount
 		sa, err = serviceaccount.Get(context.Client, context.Forwarder.Namespace, context.Forwarder.Spec.ServiceAccount.Name)
@@ -0,0 +1,71 @@
+package observability
+
+import (
+	"context"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/set"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+const (
+	// SecretIndexKey is the field index of the secret names referenced by a ClusterLogForwarder
+	SecretIndexKey = ".spec.secretNames"
+	// ConfigMapIndexKey is the field index of the configmap names referenced by a ClusterLogForwarder
+	ConfigMapIndexKey = ".spec.configMapNames"
+)
+
+// SecretNamesIndexer returns the names of the secrets referenced by the inputs, outputs and filters of a ClusterLogForwarder
+func SecretNamesIndexer(obj client.Object) []string {
+	forwarder, ok := obj.(*obsv1.ClusterLogForwarder)
+	if !ok {
+		return nil
+	}
+	names := set.New(internalobs.Inputs(forwarder.Spec.Inputs).SecretNames()...)
+	names.Insert(internalobs.Outputs(forwarder.Spec.Outputs).SecretNames()...)
+	names.Insert(internalobs.Filters(forwarder.Spec.Filters).SecretNames()...)
+	return names.SortedList()
+}
+
+// ConfigMapNamesIndexer returns the names of the configmaps referenced by the inputs, outputs and filters of a ClusterLogForwarder
+func ConfigMapNamesIndexer(obj client.Object) []string {
+	forwarder, ok := obj.(*obsv1.ClusterLogForwarder)
+	if !ok {
+		return nil
+	}
+	names := set.New(internalobs.Inputs(forwarder.Spec.Inputs).ConfigmapNames()...)
+	names.Insert(internalobs.Outputs(forwarder.Spec.Outputs).ConfigmapNames()...)
+	names.Insert(internalobs.Filters(forwarder.Spec.Filters).ConfigmapNames()...)
+	return names.SortedList()
+}
+
+// MapToReferencingForwarders returns a function that maps a changed object to a reconcile request for every
+// ClusterLogForwarder in the same namespace whose given field index references the object by name
+func MapToReferencingForwarders(k8sClient client.Client, indexKey string) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []ctrl.Request {
+		forwarders := &obsv1.ClusterLogForwarderList{}
+		if err := k8sClient.List(ctx, forwarders,
+			client.InNamespace(obj.GetNamespace()),
+			client.MatchingFields{indexKey: obj.GetName()},
+		); err != nil {
+			log.WithName(loggerName).V(0).Error(err, "unable to list forwarders referencing object", "index", indexKey, "namespace", obj.GetNamespace(), "name", obj.GetName())
+			return nil
+		}
+		requests := make([]ctrl.Request, 0, len(forwarders.Items))
+		for _, f := range forwarders.Items {
+			log.WithName(loggerName).V(3).Info("enqueue forwarder for referenced object change", "index", indexKey, "object", obj.GetName(), "forwarder", f.Name)
+			requests = append(requests, ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: f.Namespace,
+					Name:      f.Name,
+				},
+			})
+		}
+		return requests
+	}
+}
@@ -0,0 +1,92 @@
+package observability_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/controller/observability"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	obsruntime "github.com/openshift/cluster-logging-operator/internal/runtime/observability"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Watching referenced Secrets and ConfigMaps", func() {
+
+	const (
+		namespace  = "mylogging"
+		secretName = "splunk-secret"
+		caName     = "my-ca"
+	)
+
+	var (
+		splunkForwarder = obsruntime.NewClusterLogForwarder(namespace, "splunk", runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+			clf.Spec.Outputs = []obs.OutputSpec{
+				{
+					Name: "splunk",
+					Type: obs.OutputTypeSplunk,
+					Splunk: &obs.Splunk{
+						Authentication: &obs.SplunkAuthentication{
+							Token: &obs.SecretReference{Key: "hecToken", SecretName: secretName},
+						},
+					},
+					TLS: &obs.OutputTLSSpec{
+						TLSSpec: obs.TLSSpec{
+							CA: &obs.ValueReference{Key: "ca-bundle.crt", ConfigMapName: caName},
+						},
+					},
+				},
+			}
+		})
+		otherForwarder = obsruntime.NewClusterLogForwarder(namespace, "other", runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+			clf.Spec.Outputs = []obs.OutputSpec{
+				{
+					Name: "http",
+					Type: obs.OutputTypeHTTP,
+					HTTP: &obs.HTTP{},
+				},
+			}
+		})
+		otherNamespaceForwarder = obsruntime.NewClusterLogForwarder("other-namespace", "splunk", runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+			clf.Spec.Outputs = splunkForwarder.Spec.Outputs
+		})
+	)
+
+	It("should index the secret and configmap names referenced by a forwarder", func() {
+		Expect(observability.SecretNamesIndexer(splunkForwarder)).To(Equal([]string{secretName}))
+		Expect(observability.ConfigMapNamesIndexer(splunkForwarder)).To(Equal([]string{caName}))
+		Expect(observability.SecretNamesIndexer(otherForwarder)).To(BeEmpty())
+	})
+
+	Context("when mapping a changed object to forwarders", func() {
+		k8sClient := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(splunkForwarder, otherForwarder, otherNamespaceForwarder).
+			WithIndex(&obs.ClusterLogForwarder{}, observability.SecretIndexKey, observability.SecretNamesIndexer).
+			WithIndex(&obs.ClusterLogForwarder{}, observability.ConfigMapIndexKey, observability.ConfigMapNamesIndexer).
+			Build()
+
+		It("should enqueue only the forwarders in the namespace that reference a secret", func() {
+			mapFn := observability.MapToReferencingForwarders(k8sClient, observability.SecretIndexKey)
+			Expect(mapFn(context.TODO(), runtime.NewSecret(namespace, secretName, nil))).To(Equal([]ctrl.Request{
+				{NamespacedName: types.NamespacedName{Namespace: namespace, Name: "splunk"}},
+			}))
+		})
+
+		It("should enqueue only the forwarders in the namespace that reference a configmap", func() {
+			mapFn := observability.MapToReferencingForwarders(k8sClient, observability.ConfigMapIndexKey)
+			Expect(mapFn(context.TODO(), runtime.NewConfigMap(namespace, caName, nil))).To(Equal([]ctrl.Request{
+				{NamespacedName: types.NamespacedName{Namespace: namespace, Name: "splunk"}},
+			}))
+		})
+
+		It("should not enqueue anything for an object that is not referenced", func() {
+			mapFn := observability.MapToReferencingForwarders(k8sClient, observability.SecretIndexKey)
+			Expect(mapFn(context.TODO(), runtime.NewSecret(namespace, "unreferenced", nil))).To(BeEmpty())
+		})
+	})
+})
@@ -11,6 +11,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/input"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/metrics"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/pipeline"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/source"
@@ -94,9 +95,14 @@ func Conf(secrets map[string]*corev1.Secret, clfspec obs.ClusterLogForwarderSpec
 	}
 
 	minTlsVersion, cipherSuites := framework.TLSProfileInfo(op, obs.OutputSpec{}, ",")
+	global := Global(namespace, forwarderName)
+	if len(internalobs.Filters(clfspec.Filters).ConfigmapNames()) > 0 {
+		global = append(global, common.NewVectorConfigMap())
+	}
+
 	return []framework.Section{
 		{
-			Global(namespace, forwarderName),
+			global,
 			`vector global options`,
 		},
 		{
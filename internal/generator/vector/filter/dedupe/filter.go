@@ -0,0 +1,95 @@
+package dedupe
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	defaultCacheSize = int64(5000)
+
+	// windowField is the temporary field identifying the time window in which a record is received
+	windowField = "_dedupe_window"
+)
+
+// NewDedupe returns a dedupe transform that drops records with the same field values as a cached record.
+// When a window is spec'd, the transform is enclosed by remap transforms which add and remove the
+// time window of the record so it is matched along with the spec'd fields
+func NewDedupe(id string, spec *obs.DedupeFilterSpec, inputs ...string) framework.Element {
+	cacheSize := defaultCacheSize
+	if spec.CacheSize != nil {
+		cacheSize = *spec.CacheSize
+	}
+	fields := spec.Fields
+	var windowMs int64
+	if spec.WindowMs != nil {
+		windowMs = *spec.WindowMs
+		fields = append(append([]obs.FieldPath{}, fields...), "."+windowField)
+	}
+	return Dedupe{
+		ComponentID: id,
+		Inputs:      helpers.MakeInputs(inputs...),
+		Fields:      makeFields(fields),
+		CacheSize:   cacheSize,
+		WindowMs:    windowMs,
+		WindowField: windowField,
+	}
+}
+
+type Dedupe struct {
+	ComponentID string
+	Inputs      string
+	Fields      string
+	CacheSize   int64
+	WindowMs    int64
+	WindowField string
+}
+
+func (d Dedupe) Name() string {
+	return "dedupe"
+}
+
+func (d Dedupe) Template() string {
+	return `{{define "dedupe" -}}
+{{if .WindowMs -}}
+[transforms.{{.ComponentID}}_window]
+type = "remap"
+inputs = {{.Inputs}}
+source = '''
+  .{{.WindowField}} = floor(to_unix_timestamp(now(), unit: "milliseconds") / {{.WindowMs}})
+'''
+
+[transforms.{{.ComponentID}}_dedupe]
+type = "dedupe"
+inputs = ["{{.ComponentID}}_window"]
+fields.match = {{.Fields}}
+cache.num_events = {{.CacheSize}}
+
+[transforms.{{.ComponentID}}]
+type = "remap"
+inputs = ["{{.ComponentID}}_dedupe"]
+source = '''
+  del(.{{.WindowField}})
+'''
+{{- else -}}
+[transforms.{{.ComponentID}}]
+type = "dedupe"
+inputs = {{.Inputs}}
+fields.match = {{.Fields}}
+cache.num_events = {{.CacheSize}}
+{{- end}}
+{{end}}`
+}
+
+// makeFields returns the list of fields as a TOML array of paths without the leading '.'
+func makeFields(fields []obs.FieldPath) string {
+	out := make([]string, len(fields))
+	for i, f := range fields {
+		out[i] = fmt.Sprintf("%q", strings.TrimPrefix(string(f), "."))
+	}
+	return fmt.Sprintf("[%s]", strings.Join(out, ","))
+}
@@ -0,0 +1,55 @@
+package dedupe
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("dedupe filter", func() {
+
+	It("should drop duplicates of the spec'd fields using the default cache size", func() {
+		spec := &obs.DedupeFilterSpec{
+			Fields: []obs.FieldPath{".message", ".kubernetes.pod_name"},
+		}
+		Expect(`
+[transforms.my_filter]
+type = "dedupe"
+inputs = ["application"]
+fields.match = ["message","kubernetes.pod_name"]
+cache.num_events = 5000
+`).To(EqualConfigFrom(NewDedupe("my_filter", spec, "application")))
+	})
+
+	It("should drop duplicates received within the same time window", func() {
+		spec := &obs.DedupeFilterSpec{
+			Fields:    []obs.FieldPath{".message"},
+			CacheSize: utils.GetPtr(int64(100)),
+			WindowMs:  utils.GetPtr(int64(60000)),
+		}
+		Expect(`
+[transforms.my_filter_window]
+type = "remap"
+inputs = ["application"]
+source = '''
+  ._dedupe_window = floor(to_unix_timestamp(now(), unit: "milliseconds") / 60000)
+'''
+
+[transforms.my_filter_dedupe]
+type = "dedupe"
+inputs = ["my_filter_window"]
+fields.match = ["message","_dedupe_window"]
+cache.num_events = 100
+
+[transforms.my_filter]
+type = "remap"
+inputs = ["my_filter_dedupe"]
+source = '''
+  del(._dedupe_window)
+'''
+`).To(EqualConfigFrom(NewDedupe("my_filter", spec, "application")))
+		Expect(spec.Fields).To(Equal([]obs.FieldPath{".message"}), "expected the spec not to be modified")
+	})
+})
@@ -0,0 +1,13 @@
+package dedupe
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestDedupe(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[filter][dedupe] Unit Tests")
+}
@@ -16,8 +16,15 @@ func NewFilter(dropTestsSpec []obs.DropTest) *Filter {
 }
 
 func (f *Filter) VRL() (string, error) {
+	// Vector's transform.Filter keeps logs that match the condition
+	// Need `!()` to negate the whole expression if any condition evaluates to TRUE to drop logs
+	return "!(" + MatchTests(f.tests) + ")", nil
+}
+
+// MatchTests returns the VRL condition that evaluates to true when any of the tests passes
+func MatchTests(tests []obs.DropTest) string {
 	vrlTests := []string{}
-	for _, test := range f.tests {
+	for _, test := range tests {
 		condList := []string{}
 		for _, cond := range test.DropConditions {
 			if cond.Matches != "" {
@@ -32,8 +39,5 @@ func (f *Filter) VRL() (string, error) {
 		vrlCondition := "(" + strings.Join(condList, " && ") + ")"
 		vrlTests = append(vrlTests, vrlCondition)
 	}
-
-	// Vector's transform.Filter keeps logs that match the condition
-	// Need `!()` to negate the whole expression if any condition evaluates to TRUE to drop logs
-	return "!(" + strings.Join(vrlTests, " || ") + ")", nil
+	return strings.Join(vrlTests, " || ")
 }
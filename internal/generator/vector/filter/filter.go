@@ -9,9 +9,14 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/parse"
 
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/dedupe"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/drop"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/modify"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/openshift"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/prune"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/redact"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/remap"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/sample"
 
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/apiaudit"
 )
@@ -50,11 +55,32 @@ func NewInternalFilterMap(filters map[string]*obs.FilterSpec) map[string]*Intern
 			internalFilter.RemapFilter = prune.NewFilter(f.PruneFilterSpec)
 		case obs.FilterTypeKubeAPIAudit:
 			internalFilter.RemapFilter = apiaudit.NewFilter(f.KubeAPIAudit)
+		case obs.FilterTypeRemap:
+			internalFilter.RemapFilter = remap.NewFilter(f.RemapFilterSpec)
 		case obs.FilterTypeParse:
-			internalFilter.RemapFilter = parse.NewParseFilter()
+			internalFilter.RemapFilter = parse.NewParseFilter(f.ParseFilterSpec)
+		case obs.FilterTypeRedact:
+			internalFilter.RemapFilter = redact.NewFilter(f.RedactFilterSpec)
+		case obs.FilterTypeModify:
+			internalFilter.RemapFilter = modify.NewFilter(f.ModifyFilterSpec)
 		case obs.FilterTypeDetectMultiline:
 			internalFilter.SuppliesTransform = true
-			internalFilter.TranformFactory = multilineexception.NewDetectException
+			spec := f.DetectMultilineExceptionSpec
+			internalFilter.TranformFactory = func(id string, inputs ...string) framework.Element {
+				return multilineexception.NewDetectException(id, spec, inputs...)
+			}
+		case obs.FilterTypeSample:
+			internalFilter.SuppliesTransform = true
+			spec := f.SampleFilterSpec
+			internalFilter.TranformFactory = func(id string, inputs ...string) framework.Element {
+				return sample.NewSample(id, spec, inputs...)
+			}
+		case obs.FilterTypeDedupe:
+			internalFilter.SuppliesTransform = true
+			spec := f.DedupeFilterSpec
+			internalFilter.TranformFactory = func(id string, inputs ...string) framework.Element {
+				return dedupe.NewDedupe(id, spec, inputs...)
+			}
 		default:
 			log.V(0).Error(fmt.Errorf("unknown filter type: %v", f.Type), "This should have been caught by declarative API validation")
 		}
@@ -0,0 +1,65 @@
+package modify
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+	"text/template"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	commontemplate "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/template"
+)
+
+var (
+	//go:embed modify.vrl.tmpl
+	modifyVRLTemplateStr string
+	ModifyVRLTemplate    = template.Must(template.New("modify VRL").Parse(modifyVRLTemplateStr))
+
+	// coerceFunctions are the VRL functions that convert a value to a type
+	coerceFunctions = map[obs.ModifyCoerceType]string{
+		obs.ModifyCoerceString:  "to_string",
+		obs.ModifyCoerceInteger: "to_int",
+		obs.ModifyCoerceFloat:   "to_float",
+		obs.ModifyCoerceBoolean: "to_bool",
+	}
+)
+
+type Operation struct {
+	Type   obs.ModifyOperationType
+	Field  obs.FieldPath
+	Source obs.FieldPath
+	Value  string
+	Coerce string
+}
+
+type ModifyFilter obs.ModifyFilterSpec
+
+func NewFilter(spec *obs.ModifyFilterSpec) ModifyFilter {
+	return ModifyFilter(*spec)
+}
+
+func (f ModifyFilter) VRL() (string, error) {
+	operations := []Operation{}
+	for _, op := range f.Operations {
+		operation := Operation{
+			Type:   op.Type,
+			Field:  op.Field,
+			Source: op.Source,
+			Coerce: coerceFunctions[op.CoerceTo],
+		}
+		if op.Type == obs.ModifyOperationAdd {
+			if op.ValueFrom != nil {
+				operation.Value = fmt.Sprintf("%q", helpers.ValueFrom(op.ValueFrom))
+			} else {
+				operation.Value = commontemplate.TransformUserTemplateToRecordVRL(op.Value)
+			}
+		}
+		operations = append(operations, operation)
+	}
+
+	// Execute Go template to generate VRL
+	w := &strings.Builder{}
+	err := ModifyVRLTemplate.Execute(w, operations)
+	return w.String(), err
+}
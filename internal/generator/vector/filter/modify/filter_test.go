@@ -0,0 +1,83 @@
+package modify
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("modify filter", func() {
+
+	It("should generate VRL for each operation in order", func() {
+		spec := &obs.ModifyFilterSpec{
+			Operations: []obs.ModifyOperation{
+				{
+					Type:  obs.ModifyOperationAdd,
+					Field: ".environment",
+					Value: "production",
+				},
+				{
+					Type:      obs.ModifyOperationAdd,
+					Field:     ".cost_center",
+					ValueFrom: &obs.ValueReference{ConfigMapName: "cluster-info", Key: "costCenter"},
+				},
+				{
+					Type:      obs.ModifyOperationAdd,
+					Field:     ".token",
+					ValueFrom: &obs.ValueReference{SecretName: "cluster-secrets", Key: "token"},
+				},
+				{
+					Type:  obs.ModifyOperationAdd,
+					Field: ".team",
+					Value: `team-{.kubernetes.labels.team||"none"}`,
+				},
+				{
+					Type:   obs.ModifyOperationCopy,
+					Field:  ".service",
+					Source: `.kubernetes.labels."app.kubernetes.io/name"`,
+				},
+				{
+					Type:   obs.ModifyOperationRename,
+					Field:  ".app",
+					Source: ".kubernetes.labels.app",
+				},
+				{
+					Type:   obs.ModifyOperationMove,
+					Field:  ".request.id",
+					Source: ".structured.request_id",
+				},
+				{
+					Type:     obs.ModifyOperationCoerce,
+					Field:    ".structured.status",
+					CoerceTo: obs.ModifyCoerceInteger,
+				},
+			},
+		}
+		Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+.environment = "production"
+
+.cost_center = "SECRET[kubernetes_configmap.cluster-info/costCenter]"
+
+.token = "SECRET[kubernetes_secret.cluster-secrets/token]"
+
+.team = "team-" + to_string!(.kubernetes.labels.team||"none")
+
+if exists(.kubernetes.labels."app.kubernetes.io/name") {
+  .service = .kubernetes.labels."app.kubernetes.io/name"
+}
+
+if exists(.kubernetes.labels.app) {
+  .app = del(.kubernetes.labels.app)
+}
+
+if exists(.structured.request_id) {
+  .request.id = del(.structured.request_id)
+}
+
+if exists(.structured.status) {
+  .structured.status = to_int(.structured.status) ?? .structured.status
+}
+`))
+	})
+})
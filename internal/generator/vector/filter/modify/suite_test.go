@@ -0,0 +1,13 @@
+package modify
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestModifyFunctions(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[filter][modify] Unit Tests")
+}
@@ -1,20 +1,81 @@
 package multilineexception
 
 import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 )
 
-func NewDetectException(id string, inputs ...string) framework.Element {
+const (
+	defaultExpireAfterMs   = int64(2000)
+	defaultFlushIntervalMs = int64(1000)
+)
+
+var (
+	defaultGroupBy = []obs.FieldPath{".kubernetes.namespace_name", ".kubernetes.pod_name", ".kubernetes.container_name", ".kubernetes.pod_id", ".stream"}
+
+	// languages maps the API language to the language of the collector exception detector
+	languages = map[obs.MultilineExceptionLanguage]string{
+		obs.MultilineExceptionLanguageJava:   "Java",
+		obs.MultilineExceptionLanguagePython: "Python",
+		obs.MultilineExceptionLanguageGo:     "Go",
+		obs.MultilineExceptionLanguageRuby:   "Ruby",
+		obs.MultilineExceptionLanguageJS:     "Js",
+		obs.MultilineExceptionLanguagePHP:    "Php",
+		obs.MultilineExceptionLanguageDart:   "Dart",
+		obs.MultilineExceptionLanguageCSharp: "Csharp",
+	}
+)
+
+// NewDetectException returns a transform to reassemble multi-line exceptions or, when patterns are spec'd,
+// multi-line messages delimited by user-defined regular expressions
+func NewDetectException(id string, spec *obs.DetectMultilineExceptionSpec, inputs ...string) framework.Element {
+	if spec == nil {
+		spec = &obs.DetectMultilineExceptionSpec{}
+	}
+	expireAfterMs := defaultExpireAfterMs
+	if spec.ExpireAfterMs != nil {
+		expireAfterMs = *spec.ExpireAfterMs
+	}
+	flushIntervalMs := defaultFlushIntervalMs
+	if spec.FlushIntervalMs != nil {
+		flushIntervalMs = *spec.FlushIntervalMs
+	}
+	groupBy := defaultGroupBy
+	if len(spec.GroupBy) > 0 {
+		groupBy = spec.GroupBy
+	}
+	if spec.Patterns != nil {
+		return MultilinePatterns{
+			ComponentID:     id,
+			Inputs:          helpers.MakeInputs(inputs...),
+			GroupBy:         makeGroupBy(groupBy, false),
+			ExpireAfterMs:   expireAfterMs,
+			FlushIntervalMs: flushIntervalMs,
+			StartsWhen:      startsWhen(*spec.Patterns),
+			EndsWhen:        matchMessage(spec.Patterns.EndPattern),
+		}
+	}
 	return DetectExceptions{
-		ComponentID: id,
-		Inputs:      helpers.MakeInputs(inputs...),
+		ComponentID:     id,
+		Inputs:          helpers.MakeInputs(inputs...),
+		Languages:       makeLanguages(spec.Languages),
+		GroupBy:         makeGroupBy(groupBy, true),
+		ExpireAfterMs:   expireAfterMs,
+		FlushIntervalMs: flushIntervalMs,
 	}
 }
 
 type DetectExceptions struct {
-	ComponentID string
-	Inputs      string
+	ComponentID     string
+	Inputs          string
+	Languages       string
+	GroupBy         string
+	ExpireAfterMs   int64
+	FlushIntervalMs int64
 }
 
 func (d DetectExceptions) Name() string {
@@ -26,9 +87,90 @@ func (d DetectExceptions) Template() string {
 [transforms.{{.ComponentID}}]
 type = "detect_exceptions"
 inputs = {{.Inputs}}
-languages = ["All"]
-group_by = ["kubernetes.namespace_name","kubernetes.pod_name","kubernetes.container_name", "kubernetes.pod_id", "stream"]
-expire_after_ms = 2000
-multiline_flush_interval_ms = 1000
+languages = {{.Languages}}
+group_by = {{.GroupBy}}
+expire_after_ms = {{.ExpireAfterMs}}
+multiline_flush_interval_ms = {{.FlushIntervalMs}}
 {{end}}`
 }
+
+// MultilinePatterns is a reduce transform that merges the messages of consecutive lines using regular expressions
+// to identify the start or end of a message
+type MultilinePatterns struct {
+	ComponentID     string
+	Inputs          string
+	GroupBy         string
+	ExpireAfterMs   int64
+	FlushIntervalMs int64
+	StartsWhen      string
+	EndsWhen        string
+}
+
+func (m MultilinePatterns) Name() string {
+	return "multilinePatterns"
+}
+
+func (m MultilinePatterns) Template() string {
+	return `{{define "multilinePatterns" -}}
+[transforms.{{.ComponentID}}]
+type = "reduce"
+inputs = {{.Inputs}}
+group_by = {{.GroupBy}}
+expire_after_ms = {{.ExpireAfterMs}}
+flush_period_ms = {{.FlushIntervalMs}}
+merge_strategies.message = "concat_newline"
+{{- if .StartsWhen}}
+starts_when = '''
+{{.StartsWhen}}
+'''
+{{- end}}
+{{- if .EndsWhen}}
+ends_when = '''
+{{.EndsWhen}}
+'''
+{{- end}}
+{{end}}`
+}
+
+// startsWhen returns the VRL condition identifying the first line of a message
+func startsWhen(patterns obs.MultilinePatterns) string {
+	conditions := []string{}
+	if patterns.StartPattern != "" {
+		conditions = append(conditions, matchMessage(patterns.StartPattern))
+	}
+	if patterns.ContinuationPattern != "" {
+		conditions = append(conditions, "!"+matchMessage(patterns.ContinuationPattern))
+	}
+	return strings.Join(conditions, " || ")
+}
+
+func matchMessage(pattern string) string {
+	if pattern == "" {
+		return ""
+	}
+	return fmt.Sprintf(`match(to_string(.message) ?? "", r'%s')`, strings.ReplaceAll(pattern, "'", `\'`))
+}
+
+func makeLanguages(langs []obs.MultilineExceptionLanguage) string {
+	if len(langs) == 0 {
+		return `["All"]`
+	}
+	out := make([]string, len(langs))
+	for i, l := range langs {
+		out[i] = fmt.Sprintf("%q", languages[l])
+	}
+	return fmt.Sprintf("[%s]", strings.Join(out, ","))
+}
+
+// makeGroupBy returns the list of fields as a TOML array, optionally trimming the leading '.' of each path
+func makeGroupBy(fields []obs.FieldPath, trimDot bool) string {
+	out := make([]string, len(fields))
+	for i, f := range fields {
+		path := string(f)
+		if trimDot {
+			path = strings.TrimPrefix(path, ".")
+		}
+		out[i] = fmt.Sprintf("%q", path)
+	}
+	return fmt.Sprintf("[%s]", strings.Join(out, ","))
+}
@@ -0,0 +1,83 @@
+package multilineexception
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("detect multiline exception filter", func() {
+
+	It("should detect exceptions for all languages using the defaults when the spec is not defined", func() {
+		Expect(`
+[transforms.my_filter]
+type = "detect_exceptions"
+inputs = ["application"]
+languages = ["All"]
+group_by = ["kubernetes.namespace_name","kubernetes.pod_name","kubernetes.container_name","kubernetes.pod_id","stream"]
+expire_after_ms = 2000
+multiline_flush_interval_ms = 1000
+`).To(EqualConfigFrom(NewDetectException("my_filter", nil, "application")))
+	})
+
+	It("should detect exceptions for the spec'd languages, timeouts and group by fields", func() {
+		spec := &obs.DetectMultilineExceptionSpec{
+			Languages:       []obs.MultilineExceptionLanguage{obs.MultilineExceptionLanguageJava, obs.MultilineExceptionLanguageCSharp},
+			ExpireAfterMs:   utils.GetPtr(int64(5000)),
+			FlushIntervalMs: utils.GetPtr(int64(500)),
+			GroupBy:         []obs.FieldPath{".kubernetes.pod_id", ".stream"},
+		}
+		Expect(`
+[transforms.my_filter]
+type = "detect_exceptions"
+inputs = ["application"]
+languages = ["Java","Csharp"]
+group_by = ["kubernetes.pod_id","stream"]
+expire_after_ms = 5000
+multiline_flush_interval_ms = 500
+`).To(EqualConfigFrom(NewDetectException("my_filter", spec, "application")))
+	})
+
+	It("should merge lines using the start and continuation patterns", func() {
+		spec := &obs.DetectMultilineExceptionSpec{
+			Patterns: &obs.MultilinePatterns{
+				StartPattern:        `^\d{4}-\d{2}-\d{2}`,
+				ContinuationPattern: `^\s+`,
+			},
+		}
+		Expect(`
+[transforms.my_filter]
+type = "reduce"
+inputs = ["application"]
+group_by = [".kubernetes.namespace_name",".kubernetes.pod_name",".kubernetes.container_name",".kubernetes.pod_id",".stream"]
+expire_after_ms = 2000
+flush_period_ms = 1000
+merge_strategies.message = "concat_newline"
+starts_when = '''
+match(to_string(.message) ?? "", r'^\d{4}-\d{2}-\d{2}') || !match(to_string(.message) ?? "", r'^\s+')
+'''
+`).To(EqualConfigFrom(NewDetectException("my_filter", spec, "application")))
+	})
+
+	It("should merge lines using the end pattern", func() {
+		spec := &obs.DetectMultilineExceptionSpec{
+			Patterns: &obs.MultilinePatterns{
+				EndPattern: `;$`,
+			},
+		}
+		Expect(`
+[transforms.my_filter]
+type = "reduce"
+inputs = ["application"]
+group_by = [".kubernetes.namespace_name",".kubernetes.pod_name",".kubernetes.container_name",".kubernetes.pod_id",".stream"]
+expire_after_ms = 2000
+flush_period_ms = 1000
+merge_strategies.message = "concat_newline"
+ends_when = '''
+match(to_string(.message) ?? "", r';$')
+'''
+`).To(EqualConfigFrom(NewDetectException("my_filter", spec, "application")))
+	})
+})
@@ -0,0 +1,13 @@
+package multilineexception
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestMultilineException(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[filter][multilineexception] Unit Tests")
+}
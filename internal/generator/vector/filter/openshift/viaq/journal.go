@@ -122,10 +122,11 @@ func journalLogsVRL() string {
 	}), "\n\n")
 }
 
+// DropJournalDebugLogs drops the debug records of the journal and passes the records of any other source
 func DropJournalDebugLogs(id string, inputs ...string) framework.Element {
 	return Filter{
 		ComponentID: id,
 		Inputs:      helpers.MakeInputs(inputs...),
-		Condition:   `(.log_source == "node" && .PRIORITY != "7" && .PRIORITY != 7)  || .log_source == "container" || .log_type == "audit"`,
+		Condition:   `!(.log_source == "node" && (.PRIORITY == "7" || .PRIORITY == 7))`,
 	}
 }
@@ -0,0 +1,144 @@
+package parse
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+	"text/template"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+const (
+	DefaultSource = obs.FieldPath(".message")
+	DefaultTarget = obs.FieldPath(".structured")
+
+	defaultCSVDelimiter      = ","
+	defaultKeyValueDelimiter = "="
+	defaultFieldDelimiter    = " "
+)
+
+var (
+	ParseVRLTemplate = template.Must(template.New("parse VRL").Parse(parseVRLTemplateStr))
+
+	//go:embed parse.vrl.tmpl
+	parseVRLTemplateStr string
+
+	defaultLogSources = []obs.LogSource{obs.LogSource(obs.ApplicationSourceContainer)}
+)
+
+type Parse struct {
+	Condition    string
+	Parser       string
+	Source       obs.FieldPath
+	Target       obs.FieldPath
+	Value        string
+	DeleteSource bool
+}
+
+type Filter struct {
+	spec obs.ParseFilterSpec
+}
+
+// NewParseFilter returns a parse filter. A nil spec parses the message of container logs as JSON into the structured field
+func NewParseFilter(spec *obs.ParseFilterSpec) Filter {
+	if spec == nil {
+		spec = &obs.ParseFilterSpec{}
+	}
+	return Filter{spec: *spec}
+}
+
+func (f Filter) VRL() (string, error) {
+	parse := Parse{
+		Source:    DefaultSource,
+		Target:    DefaultTarget,
+		Value:     "parsed",
+		Condition: logSourceCondition(f.spec.LogSources),
+	}
+	if f.spec.Source != "" {
+		parse.Source = f.spec.Source
+	}
+	if f.spec.Target != "" {
+		parse.Target = f.spec.Target
+	}
+	parse.DeleteSource = !f.spec.KeepOriginal && parse.Source != parse.Target
+
+	var err error
+	if parse.Parser, err = parser(f.spec, parse.Source); err != nil {
+		return "", err
+	}
+	if f.spec.Format == obs.ParseFormatCSV {
+		parse.Value = csvObject(f.spec.CSV.Headers)
+	}
+
+	// Execute Go template to generate VRL
+	w := &strings.Builder{}
+	err = ParseVRLTemplate.Execute(w, parse)
+	return w.String(), err
+}
+
+// parser returns the VRL function call that parses the source field for the format of the spec
+func parser(spec obs.ParseFilterSpec, source obs.FieldPath) (string, error) {
+	switch spec.Format {
+	case "", obs.ParseFormatJSON:
+		return fmt.Sprintf("parse_json(%s)", source), nil
+	case obs.ParseFormatLogfmt:
+		return fmt.Sprintf("parse_logfmt(%s)", source), nil
+	case obs.ParseFormatKeyValue:
+		kvDelimiter, fieldDelimiter := defaultKeyValueDelimiter, defaultFieldDelimiter
+		if spec.KeyValue != nil {
+			if spec.KeyValue.KeyValueDelimiter != "" {
+				kvDelimiter = spec.KeyValue.KeyValueDelimiter
+			}
+			if spec.KeyValue.FieldDelimiter != "" {
+				fieldDelimiter = spec.KeyValue.FieldDelimiter
+			}
+		}
+		return fmt.Sprintf("parse_key_value(%s, key_value_delimiter: %q, field_delimiter: %q)", source, kvDelimiter, fieldDelimiter), nil
+	case obs.ParseFormatRegex:
+		if spec.Regex == nil {
+			return "", fmt.Errorf("regex is required for parse format %q", spec.Format)
+		}
+		return fmt.Sprintf("parse_regex(%s, r'%s')", source, strings.ReplaceAll(spec.Regex.Pattern, "'", `\'`)), nil
+	case obs.ParseFormatCSV:
+		if spec.CSV == nil || len(spec.CSV.Headers) == 0 {
+			return "", fmt.Errorf("csv headers are required for parse format %q", spec.Format)
+		}
+		delimiter := defaultCSVDelimiter
+		if spec.CSV.Delimiter != "" {
+			delimiter = spec.CSV.Delimiter
+		}
+		return fmt.Sprintf("parse_csv(%s, delimiter: %q)", source, delimiter), nil
+	case obs.ParseFormatSyslog:
+		return fmt.Sprintf("parse_syslog(%s)", source), nil
+	case obs.ParseFormatCommonLog:
+		return fmt.Sprintf("parse_common_log(%s)", source), nil
+	case obs.ParseFormatNginxCombined:
+		return fmt.Sprintf(`parse_nginx_log(%s, format: "combined")`, source), nil
+	}
+	return "", fmt.Errorf("unsupported parse format: %q", spec.Format)
+}
+
+// csvObject returns a VRL object that maps each header to the value at the same index of the parsed row
+func csvObject(headers []string) string {
+	fields := make([]string, len(headers))
+	for i, h := range headers {
+		fields[i] = fmt.Sprintf("%q: parsed[%d]", h, i)
+	}
+	return fmt.Sprintf("{%s}", strings.Join(fields, ", "))
+}
+
+// logSourceCondition returns the VRL condition that restricts parsing to records from the given log sources
+func logSourceCondition(sources []obs.LogSource) string {
+	if len(sources) == 0 {
+		sources = defaultLogSources
+	}
+	if len(sources) == 1 {
+		return fmt.Sprintf(".log_source == %q", sources[0])
+	}
+	quoted := make([]string, len(sources))
+	for i, s := range sources {
+		quoted[i] = fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprintf("includes([%s], .log_source)", strings.Join(quoted, ", "))
+}
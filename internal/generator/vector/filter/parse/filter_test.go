@@ -0,0 +1,91 @@
+package parse
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("parse filter", func() {
+
+	Context("#VRL", func() {
+		It("should parse container messages as JSON into structured when the spec is not defined", func() {
+			Expect(NewParseFilter(nil).VRL()).To(matchers.EqualTrimLines(`
+if .log_source == "container" {
+	parsed, err = parse_json(.message)
+	if err == null {
+		.structured = parsed
+		del(.message)
+	}
+}
+`))
+		})
+
+		It("should parse the source into the target for the requested log sources and keep the original", func() {
+			spec := &obs.ParseFilterSpec{
+				Format:       obs.ParseFormatLogfmt,
+				Source:       ".msg",
+				Target:       `.kubernetes."parsed-msg"`,
+				KeepOriginal: true,
+				LogSources:   []obs.LogSource{"container", "node"},
+			}
+			Expect(NewParseFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+if includes(["container", "node"], .log_source) {
+	parsed, err = parse_logfmt(.msg)
+	if err == null {
+		.kubernetes."parsed-msg" = parsed
+	}
+}
+`))
+		})
+
+		It("should not delete the source when it is also the target", func() {
+			spec := &obs.ParseFilterSpec{
+				Format: obs.ParseFormatJSON,
+				Target: ".message",
+			}
+			Expect(NewParseFilter(spec).VRL()).ToNot(ContainSubstring("del("))
+		})
+
+		It("should map each csv header to a value of the parsed row", func() {
+			spec := &obs.ParseFilterSpec{
+				Format: obs.ParseFormatCSV,
+				CSV: &obs.ParseCSV{
+					Headers:   []string{"time", "level", "msg"},
+					Delimiter: ";",
+				},
+			}
+			Expect(NewParseFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+if .log_source == "container" {
+	parsed, err = parse_csv(.message, delimiter: ";")
+	if err == null {
+		.structured = {"time": parsed[0], "level": parsed[1], "msg": parsed[2]}
+		del(.message)
+	}
+}
+`))
+		})
+
+		DescribeTable("should generate the parser for the format", func(spec obs.ParseFilterSpec, exp string) {
+			Expect(NewParseFilter(&spec).VRL()).To(ContainSubstring(exp))
+		},
+			Entry("json", obs.ParseFilterSpec{Format: obs.ParseFormatJSON}, "parsed, err = parse_json(.message)"),
+			Entry("key/value with defaults", obs.ParseFilterSpec{Format: obs.ParseFormatKeyValue},
+				`parsed, err = parse_key_value(.message, key_value_delimiter: "=", field_delimiter: " ")`),
+			Entry("key/value", obs.ParseFilterSpec{Format: obs.ParseFormatKeyValue, KeyValue: &obs.ParseKeyValue{KeyValueDelimiter: ":", FieldDelimiter: ","}},
+				`parsed, err = parse_key_value(.message, key_value_delimiter: ":", field_delimiter: ",")`),
+			Entry("regex", obs.ParseFilterSpec{Format: obs.ParseFormatRegex, Regex: &obs.ParseRegex{Pattern: `^(?P<level>\w+) '(?P<msg>.*)'$`}},
+				`parsed, err = parse_regex(.message, r'^(?P<level>\w+) \'(?P<msg>.*)\'$')`),
+			Entry("syslog", obs.ParseFilterSpec{Format: obs.ParseFormatSyslog}, "parsed, err = parse_syslog(.message)"),
+			Entry("common log", obs.ParseFilterSpec{Format: obs.ParseFormatCommonLog}, "parsed, err = parse_common_log(.message)"),
+			Entry("nginx combined", obs.ParseFilterSpec{Format: obs.ParseFormatNginxCombined}, `parsed, err = parse_nginx_log(.message, format: "combined")`),
+		)
+
+		It("should fail when the regex is not defined for the regex format", func() {
+			_, err := NewParseFilter(&obs.ParseFilterSpec{Format: obs.ParseFormatRegex}).VRL()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,95 @@
+package redact
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+	"text/template"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	defaultMask         = "[REDACTED]"
+	defaultRevealLength = int64(4)
+)
+
+var (
+	//go:embed redact.vrl.tmpl
+	redactVRLTemplateStr string
+	RedactVRLTemplate    = template.Must(template.New("redact VRL").Parse(redactVRLTemplateStr))
+
+	defaultFields = []obs.FieldPath{".message"}
+
+	// Patterns are the regular expressions of the built-in patterns
+	Patterns = map[obs.RedactPattern]string{
+		obs.RedactPatternCreditCard: `\b(?:\d[ -]?){12,18}\d\b`,
+		obs.RedactPatternEmail:      `[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}`,
+		obs.RedactPatternIPv4:       `\b(?:(?:25[0-5]|2[0-4]\d|1?\d?\d)\.){3}(?:25[0-5]|2[0-4]\d|1?\d?\d)\b`,
+		obs.RedactPatternIPv6:       `\b(?:[0-9a-fA-F]{1,4}:){7}[0-9a-fA-F]{1,4}\b|\b(?:[0-9a-fA-F]{1,4}:){1,7}:(?:[0-9a-fA-F]{1,4}(?::[0-9a-fA-F]{1,4}){0,6})?`,
+		obs.RedactPatternJWT:        `\beyJ[a-zA-Z0-9_-]+\.eyJ[a-zA-Z0-9_-]+\.[a-zA-Z0-9_-]+`,
+		obs.RedactPatternAWSKey:     `\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`,
+	}
+)
+
+type Redact struct {
+	Fields       []obs.FieldPath
+	Pattern      string
+	Type         obs.RedactReplacementType
+	Mask         string
+	Salt         string
+	RevealLength int64
+}
+
+type RedactFilter obs.RedactFilterSpec
+
+func NewFilter(spec *obs.RedactFilterSpec) RedactFilter {
+	return RedactFilter(*spec)
+}
+
+func (f RedactFilter) VRL() (string, error) {
+	redact := Redact{
+		Fields:       f.Fields,
+		Pattern:      f.pattern(),
+		Type:         obs.RedactReplacementMask,
+		Mask:         defaultMask,
+		RevealLength: defaultRevealLength,
+	}
+	if len(redact.Fields) == 0 {
+		redact.Fields = defaultFields
+	}
+	if r := f.Replacement; r != nil {
+		redact.Type = r.Type
+		if r.Mask != "" {
+			redact.Mask = r.Mask
+		}
+		if r.RevealLength != nil {
+			redact.RevealLength = *r.RevealLength
+		}
+		redact.Salt = fmt.Sprintf("%q", helpers.SecretFrom(r.Salt))
+	}
+	if redact.Type == obs.RedactReplacementMask {
+		// '$' references a capture group in the replacement of VRL replace
+		redact.Mask = strings.ReplaceAll(redact.Mask, "$", "$$")
+	}
+	redact.Mask = fmt.Sprintf("%q", redact.Mask)
+
+	// Execute Go template to generate VRL
+	w := &strings.Builder{}
+	err := RedactVRLTemplate.Execute(w, redact)
+	return w.String(), err
+}
+
+// pattern returns the regular expression matching any of the built-in and custom patterns
+func (f RedactFilter) pattern() string {
+	patterns := []string{}
+	for _, p := range f.Patterns {
+		patterns = append(patterns, Patterns[p])
+	}
+	patterns = append(patterns, f.CustomPatterns...)
+	for i, p := range patterns {
+		patterns[i] = "(?:" + p + ")"
+	}
+	return strings.ReplaceAll(strings.Join(patterns, "|"), "'", `\'`)
+}
@@ -0,0 +1,13 @@
+package redact
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRedactFunctions(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[filter][redact] Unit Tests")
+}
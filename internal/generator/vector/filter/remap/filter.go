@@ -0,0 +1,49 @@
+package remap
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+const (
+	// savedFieldsVar is the variable holding the reserved fields while the user VRL runs
+	savedFieldsVar = "clo_reserved_fields"
+)
+
+// ReservedFields are required by the normalization filters which follow user filters in a pipeline. They are
+// restored after the user VRL runs so the program cannot change or remove them
+var ReservedFields = []string{"log_type", "log_source", "kubernetes"}
+
+type Filter struct {
+	vrl string
+}
+
+// NewFilter returns a filter that applies user-supplied VRL
+func NewFilter(spec *obs.RemapFilterSpec) Filter {
+	if spec == nil {
+		return Filter{}
+	}
+	return Filter{vrl: spec.VRL}
+}
+
+func (f Filter) VRL() (string, error) {
+	if strings.TrimSpace(f.vrl) == "" {
+		return "", nil
+	}
+	saved := make([]string, len(ReservedFields))
+	restore := make([]string, len(ReservedFields))
+	for i, field := range ReservedFields {
+		saved[i] = fmt.Sprintf("%q: .%s", field, field)
+		restore[i] = fmt.Sprintf(`del(.%[1]s)
+if %[2]s.%[1]s != null {
+  .%[1]s = %[2]s.%[1]s
+}`, field, savedFieldsVar)
+	}
+	return strings.Join([]string{
+		fmt.Sprintf("%s = {%s}", savedFieldsVar, strings.Join(saved, ", ")),
+		strings.TrimSpace(f.vrl),
+		strings.Join(restore, "\n"),
+	}, "\n"), nil
+}
@@ -0,0 +1,39 @@
+package remap
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("remap filter", func() {
+
+	It("should restore the reserved fields after the user VRL runs", func() {
+		spec := &obs.RemapFilterSpec{VRL: `
+. = merge(., {"log_type": "x"})
+.message = upcase(string!(.message))
+`}
+		Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+clo_reserved_fields = {"log_type": .log_type, "log_source": .log_source, "kubernetes": .kubernetes}
+. = merge(., {"log_type": "x"})
+.message = upcase(string!(.message))
+del(.log_type)
+if clo_reserved_fields.log_type != null {
+  .log_type = clo_reserved_fields.log_type
+}
+del(.log_source)
+if clo_reserved_fields.log_source != null {
+  .log_source = clo_reserved_fields.log_source
+}
+del(.kubernetes)
+if clo_reserved_fields.kubernetes != null {
+  .kubernetes = clo_reserved_fields.kubernetes
+}
+`))
+	})
+
+	It("should generate no VRL without a spec", func() {
+		Expect(NewFilter(nil).VRL()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,65 @@
+package sample
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/drop"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+// keepErrors is the condition of records that are never sampled because of their level
+const keepErrors = `match(to_string(.level) ?? "", r'^(emergency|alert|critical|error)$')`
+
+// NewSample returns a sample transform that forwards one out of every rate records except for errors
+// and the records excluded by the spec
+func NewSample(id string, spec *obs.SampleFilterSpec, inputs ...string) framework.Element {
+	exclude := []string{keepErrors}
+	if len(spec.Exclude) > 0 {
+		exclude = append(exclude, drop.MatchTests(spec.Exclude))
+	}
+	return Sample{
+		ComponentID: id,
+		Inputs:      helpers.MakeInputs(inputs...),
+		Rate:        spec.Rate,
+		KeyField:    keyField(spec.KeyField),
+		Exclude:     strings.Join(exclude, " || "),
+	}
+}
+
+type Sample struct {
+	ComponentID string
+	Inputs      string
+	Rate        int64
+	KeyField    string
+	Exclude     string
+}
+
+func (s Sample) Name() string {
+	return "sample"
+}
+
+func (s Sample) Template() string {
+	return `{{define "sample" -}}
+[transforms.{{.ComponentID}}]
+type = "sample"
+inputs = {{.Inputs}}
+rate = {{.Rate}}
+{{- if .KeyField}}
+key_field = {{.KeyField}}
+{{- end}}
+exclude = '''
+{{.Exclude}}
+'''
+{{end}}`
+}
+
+// keyField returns the field as a quoted path without the leading '.' or an empty string
+func keyField(field obs.FieldPath) string {
+	if field == "" {
+		return ""
+	}
+	return fmt.Sprintf("%q", strings.TrimPrefix(string(field), "."))
+}
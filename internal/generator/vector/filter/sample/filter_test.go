@@ -0,0 +1,47 @@
+package sample
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("sample filter", func() {
+
+	It("should sample records and always keep errors", func() {
+		Expect(`
+[transforms.my_filter]
+type = "sample"
+inputs = ["application"]
+rate = 10
+exclude = '''
+match(to_string(.level) ?? "", r'^(emergency|alert|critical|error)$')
+'''
+`).To(EqualConfigFrom(NewSample("my_filter", &obs.SampleFilterSpec{Rate: 10}, "application")))
+	})
+
+	It("should sample records by key field and keep the excluded records", func() {
+		spec := &obs.SampleFilterSpec{
+			Rate:     5,
+			KeyField: `.kubernetes.labels."trace-id"`,
+			Exclude: []obs.DropTest{
+				{
+					DropConditions: []obs.DropCondition{
+						{Field: ".kubernetes.namespace_name", Matches: "payments"},
+					},
+				},
+			},
+		}
+		Expect(`
+[transforms.my_filter]
+type = "sample"
+inputs = ["application"]
+rate = 5
+key_field = "kubernetes.labels.\"trace-id\""
+exclude = '''
+match(to_string(.level) ?? "", r'^(emergency|alert|critical|error)$') || (match(to_string(.kubernetes.namespace_name) ?? "", r'payments'))
+'''
+`).To(EqualConfigFrom(NewSample("my_filter", spec, "application")))
+	})
+})
@@ -12,7 +12,10 @@ import (
 	"golang.org/x/sys/unix"
 )
 
-const VectorSecretID = "kubernetes_secret"
+const (
+	VectorSecretID    = "kubernetes_secret"
+	VectorConfigMapID = "kubernetes_configmap"
+)
 
 var (
 	Replacer         = strings.NewReplacer(" ", "_", "-", "_", ".", "_")
@@ -80,3 +83,17 @@ func SecretFrom(secretKey *v1.SecretReference) string {
 	}
 	return ""
 }
+
+// ValueFrom formated string SECRET[<secret_component_id>.<secret_or_configmap_name>/<key>]
+func ValueFrom(valueRef *v1.ValueReference) string {
+	if valueRef == nil || valueRef.Key == "" {
+		return ""
+	}
+	if valueRef.SecretName != "" {
+		return SecretFrom(&v1.SecretReference{SecretName: valueRef.SecretName, Key: valueRef.Key})
+	}
+	if valueRef.ConfigMapName != "" {
+		return fmt.Sprintf("SECRET[%s.%s/%s]", VectorConfigMapID, valueRef.ConfigMapName, valueRef.Key)
+	}
+	return ""
+}
@@ -0,0 +1,72 @@
+package input
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/dedupe"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/source"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// eventsItemsPath is the path of the array of events of an event list
+	eventsItemsPath = ".items"
+
+	// eventsDefaultPollIntervalSeconds is the interval between two listings of the events when not configured
+	eventsDefaultPollIntervalSeconds = int64(15)
+
+	// eventsDedupeCacheSize is the number of events remembered to drop the events listed by two consecutive polls
+	// within the watermark window
+	eventsDedupeCacheSize = int64(20000)
+
+	// eventsWatermarkVRL drops the events which were not observed since the window of seconds preceding
+	// the poll. The window spans two poll intervals so an event updated between two polls is not missed
+	eventsWatermarkVRL = `
+last_observed = parse_timestamp(string(.series.lastObservedTime) ?? string(.lastTimestamp) ?? string(.eventTime) ?? string(.metadata.creationTimestamp) ?? "", "%%+") ?? now()
+if to_unix_timestamp(last_observed) < to_unix_timestamp(now()) - %d {
+  abort
+}
+`
+)
+
+// NewKubernetesEventsSource generates config elements which poll the kubernetes API for events and forward the
+// events created or updated since the previous poll. The API is accessed using the token of the collector serviceaccount
+func NewKubernetesEventsSource(input obs.InputSpec, op framework.Options) ([]framework.Element, []string) {
+	base := helpers.MakeInputID(input.Name, "events")
+	var token, caFile string
+	if name, found := utils.GetOption[string](op, framework.OptionServiceAccountTokenSecretName, ""); found {
+		token = helpers.SecretFrom(&obs.SecretReference{Key: constants.TokenKey, SecretName: name})
+		caFile = helpers.SecretPath(name, corev1.ServiceAccountRootCAKey)
+	}
+	interval := eventsDefaultPollIntervalSeconds
+	if input.Events.PollIntervalSeconds != nil {
+		interval = *input.Events.PollIntervalSeconds
+	}
+	els, sourceIDs := source.NewKubernetesEventsSources(base, token, caFile, interval, *input.Events)
+	split, splitID := source.NewSplitTransform(base, eventsItemsPath, sourceIDs...)
+	items, itemsID := source.NewItemsTransform(base, eventsItemsPath, splitID)
+	watermarkID := helpers.MakeID(base, "watermark")
+	dedupeID := helpers.MakeID(base, "dedupe")
+	metaID := helpers.MakeID(base, "meta")
+	cacheSize := eventsDedupeCacheSize
+	return append(els,
+		split,
+		items,
+		elements.Remap{
+			ComponentID: watermarkID,
+			Inputs:      helpers.MakeInputs(itemsID),
+			VRL:         fmt.Sprintf(eventsWatermarkVRL, 2*interval),
+		},
+		dedupe.NewDedupe(dedupeID, &obs.DedupeFilterSpec{
+			Fields:    []obs.FieldPath{".metadata.uid", ".metadata.resourceVersion"},
+			CacheSize: &cacheSize,
+		}, watermarkID),
+		NewKubernetesEventsMeta(metaID, *input.Events, dedupeID),
+	), []string{metaID}
+}
@@ -0,0 +1,31 @@
+package input
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/source"
+)
+
+// hostFilesToViaq sets the log source and type of the records of a hostFiles input and the name of the node
+// which replaces the host field of the file source
+const hostFilesToViaq = `.log_source = "` + string(obs.InputTypeHostFiles) + `"
+.log_type = "` + string(obs.InputTypeInfrastructure) + `"
+.hostname = get_env_var("VECTOR_SELF_NODE_NAME") ?? ""
+del(.host)
+del(.source_type)`
+
+// NewHostFilesSource generates config elements and the id reference of a hostFiles input
+func NewHostFilesSource(input obs.InputSpec) ([]framework.Element, []string) {
+	id := helpers.MakeInputID(input.Name, "host_files")
+	metaID := helpers.MakeID(id, "meta")
+	return []framework.Element{
+		source.NewHostFiles(id, *input.HostFiles),
+		elements.Remap{
+			ComponentID: metaID,
+			Inputs:      helpers.MakeInputs(id),
+			VRL:         hostFilesToViaq,
+		},
+	}, []string{metaID}
+}
@@ -10,8 +10,12 @@ import (
 func NewJournalSource(input obs.InputSpec) ([]Element, []string) {
 	id := helpers.MakeInputID(input.Name, "journal")
 	metaID := helpers.MakeID(id, "meta")
+	var journal *obs.Journal
+	if input.Infrastructure != nil {
+		journal = input.Infrastructure.Journal
+	}
 	el := []Element{
-		source.NewJournalLog(id),
+		source.NewJournalLog(id, journal),
 		NewLogSourceAndType(metaID, string(obs.InfrastructureSourceNode), string(obs.InputTypeInfrastructure), id),
 	}
 	return el, []string{metaID}
@@ -2,6 +2,10 @@ package input
 
 import (
 	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
@@ -14,3 +18,175 @@ func NewLogSourceAndType(id string, logSource, logType interface{}, inputs strin
 		VRL:         fmt.Sprintf(".log_source = %q\n.log_type = %q", logSource, logType),
 	}
 }
+
+// NewContainerStreams discards the records of containers which are not written to one of the streams
+func NewContainerStreams(id string, streams []obs.ContainerStream, inputs ...string) framework.Element {
+	quoted := make([]string, len(streams))
+	for i, s := range streams {
+		quoted[i] = fmt.Sprintf("%q", s)
+	}
+	return elements.Remap{
+		ComponentID: id,
+		Inputs:      helpers.MakeInputs(inputs...),
+		VRL:         fmt.Sprintf("if !includes([%s], .stream) {\n  abort\n}", strings.Join(quoted, ",")),
+	}
+}
+
+// NewPodAnnotationPolicy discards the records of pods and containers which opted out, or did not opt in, of collection
+// by pod annotation
+func NewPodAnnotationPolicy(id string, policy obs.PodAnnotationPolicy, inputs ...string) framework.Element {
+	collect := fmt.Sprintf(".kubernetes.annotations.%q", constants.AnnotationCollect)
+	condition := fmt.Sprintf("%s == \"false\"", collect)
+	if policy == obs.PodAnnotationPolicyOptIn {
+		condition = fmt.Sprintf("%s != \"true\"", collect)
+	}
+	vrl := fmt.Sprintf(`if %s {
+  abort
+}
+excluded_containers = split(strip_whitespace(string(.kubernetes.annotations.%q) ?? ""), r'\s*,\s*')
+if includes(excluded_containers, .kubernetes.container_name) {
+  abort
+}`, condition, constants.AnnotationExcludeContainers)
+	return elements.Remap{
+		ComponentID: id,
+		Inputs:      helpers.MakeInputs(inputs...),
+		VRL:         vrl,
+	}
+}
+
+// NewSyslogReceiverMeta sets the log source and type of the records of a syslog receiver and records the address
+// of the peer which sent the record when includePeerAddress is true
+func NewSyslogReceiverMeta(id string, includePeerAddress bool, inputs ...string) framework.Element {
+	vrl := fmt.Sprintf(".log_source = %q\n.log_type = %q", obs.InfrastructureSourceNode, obs.InputTypeInfrastructure)
+	if includePeerAddress {
+		vrl += "\n.peer_address = del(.source_ip)"
+	}
+	return elements.Remap{
+		ComponentID: id,
+		Inputs:      helpers.MakeInputs(inputs...),
+		VRL:         vrl,
+	}
+}
+
+// otlpLogsToViaq maps the resource attributes, severity and body of OTLP log records onto the viaq data model
+const otlpLogsToViaq = `
+.log_source = %q
+.log_type = %q
+resources = object(del(.resources)) ?? {}
+if exists(resources."k8s.namespace.name") { .kubernetes.namespace_name = del(resources."k8s.namespace.name") }
+if exists(resources."k8s.pod.name") { .kubernetes.pod_name = del(resources."k8s.pod.name") }
+if exists(resources."k8s.pod.uid") { .kubernetes.pod_id = del(resources."k8s.pod.uid") }
+if exists(resources."k8s.container.name") { .kubernetes.container_name = del(resources."k8s.container.name") }
+if exists(resources."host.name") {
+  .hostname = del(resources."host.name")
+} else if exists(resources."k8s.node.name") {
+  .hostname = del(resources."k8s.node.name")
+}
+.resource.attributes = resources
+severity = to_int(del(.severity_number)) ?? 0
+text = downcase(to_string(del(.severity_text)) ?? "")
+if severity >= 21 {
+  .level = "critical"
+} else if severity >= 17 {
+  .level = "error"
+} else if severity >= 13 {
+  .level = "warn"
+} else if severity >= 9 {
+  .level = "info"
+} else if severity >= 5 {
+  .level = "debug"
+} else if severity >= 1 {
+  .level = "trace"
+} else if text != "" {
+  .level = text
+} else {
+  .level = "default"
+}
+if is_object(.message) {
+  .structured = del(.message)
+}
+del(.source_type)
+`
+
+// NewOTLPReceiverMeta sets the log source and type of the records of an OTLP receiver and maps them onto the viaq data model
+func NewOTLPReceiverMeta(id string, inputs ...string) framework.Element {
+	return elements.Remap{
+		ComponentID: id,
+		Inputs:      helpers.MakeInputs(inputs...),
+		VRL:         fmt.Sprintf(otlpLogsToViaq, obs.ReceiverTypeOTLP, obs.InputTypeApplication),
+	}
+}
+
+// NewForwardReceiverMeta sets the log source and type of the records of a forward receiver and the namespace of
+// the records whose tag matches a mapping
+func NewForwardReceiverMeta(id string, tagNamespaces []obs.ForwardTagNamespace, inputs ...string) framework.Element {
+	vrl := []string{
+		fmt.Sprintf(".log_source = %q\n.log_type = %q", obs.ReceiverTypeForward, obs.InputTypeApplication),
+	}
+	if len(tagNamespaces) > 0 {
+		conditions := make([]string, len(tagNamespaces))
+		for i, mapping := range tagNamespaces {
+			conditions[i] = fmt.Sprintf("if match(to_string(.tag) ?? \"\", r'%s') {\n  .kubernetes.namespace_name = %q\n}", strings.ReplaceAll(mapping.Tag, "'", `\'`), mapping.Namespace)
+		}
+		vrl = append(vrl, strings.Join(conditions, " else "))
+	}
+	vrl = append(vrl, "del(.source_type)")
+	return elements.Remap{
+		ComponentID: id,
+		Inputs:      helpers.MakeInputs(inputs...),
+		VRL:         strings.Join(vrl, "\n"),
+	}
+}
+
+// kubernetesEventToViaq maps a kubernetes event onto the viaq data model of the events collected by the eventrouter
+// where the events of infrastructure namespaces are infrastructure logs
+const kubernetesEventToViaq = `
+event = .
+. = {"kubernetes": {"event": event}}
+.log_source = %q
+.kubernetes.namespace_name = .kubernetes.event.metadata.namespace
+ns = to_string(.kubernetes.namespace_name) ?? ""
+if ns == "" || ns == "default" || starts_with(ns, "openshift") || starts_with(ns, "kube") {
+  .log_type = %q
+} else {
+  .log_type = %q
+}
+.message = del(.kubernetes.event.message)
+."@timestamp" = string(.kubernetes.event.lastTimestamp) ?? string(.kubernetes.event.eventTime) ?? string(.kubernetes.event.metadata.creationTimestamp) ?? format_timestamp!(now(), format: "%%+")
+if .kubernetes.event.type == %q {
+  .level = "warn"
+} else if .kubernetes.event.type == %q {
+  .level = "info"
+} else {
+  .level = "default"
+}
+`
+
+// NewKubernetesEventsMeta discards the events which do not match the filters of the input and maps the others
+// onto the viaq data model
+func NewKubernetesEventsMeta(id string, events obs.KubernetesEvents, inputs ...string) framework.Element {
+	var vrl []string
+	filter := func(path string, values []string) {
+		if len(values) > 0 {
+			quoted := make([]string, len(values))
+			for i, v := range values {
+				quoted[i] = fmt.Sprintf("%q", v)
+			}
+			vrl = append(vrl, fmt.Sprintf("if !includes([%s], %s) {\n  abort\n}", strings.Join(quoted, ","), path))
+		}
+	}
+	filter(".metadata.namespace", events.Namespaces)
+	filter(".involvedObject.kind", events.InvolvedObjectKinds)
+	types := make([]string, len(events.Types))
+	for i, t := range events.Types {
+		types[i] = string(t)
+	}
+	filter(".type", types)
+	vrl = append(vrl, strings.TrimSpace(fmt.Sprintf(kubernetesEventToViaq, obs.InputTypeKubernetesEvents, obs.InputTypeInfrastructure, obs.InputTypeApplication,
+		obs.KubernetesEventTypeWarning, obs.KubernetesEventTypeNormal)))
+	return elements.Remap{
+		ComponentID: id,
+		Inputs:      helpers.MakeInputs(inputs...),
+		VRL:         strings.Join(vrl, "\n"),
+	}
+}
@@ -10,36 +10,89 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/source"
 )
 
+// auditItemsPath is the path of the array of events of a kubernetes API audit webhook request
+const auditItemsPath = ".items"
+
 func NewViaqReceiverSource(spec obs.InputSpec, resNames factory.ForwarderResourceNames, secrets observability.Secrets, op generator.Options) ([]generator.Element, []string) {
 	base := helpers.MakeInputID(spec.Name)
-	tlsConfig := receiverTLS(base, spec.Receiver.TLS, secrets, op)
+	tlsConfig := receiverTLS(base, *spec.Receiver, secrets, op)
 
 	var els []generator.Element
 	metaID := helpers.MakeID(base, "meta")
 
 	switch spec.Receiver.Type {
 	case obs.ReceiverTypeSyslog:
-		els = append(els,
-			source.NewSyslogSource(base, resNames.GenerateInputServiceName(spec.Name), spec),
-			tlsConfig,
-			NewLogSourceAndType(metaID, obs.InfrastructureSourceNode, obs.InputTypeInfrastructure, base),
-		)
+		protocol := obs.SyslogReceiverProtocolTCP
+		includePeerAddress := false
+		if spec.Receiver.Syslog != nil {
+			if spec.Receiver.Syslog.Protocol != "" {
+				protocol = spec.Receiver.Syslog.Protocol
+			}
+			includePeerAddress = spec.Receiver.Syslog.IncludePeerAddress
+		}
+		var ids []string
+		if protocol != obs.SyslogReceiverProtocolUDP {
+			els = append(els,
+				source.NewSyslogSource(base, resNames.GenerateInputServiceName(spec.Name), spec, obs.SyslogReceiverProtocolTCP),
+				tlsConfig,
+			)
+			ids = append(ids, base)
+		}
+		if protocol != obs.SyslogReceiverProtocolTCP {
+			udpID := base
+			if protocol == obs.SyslogReceiverProtocolBoth {
+				udpID = helpers.MakeID(base, "udp")
+			}
+			els = append(els, source.NewSyslogSource(udpID, resNames.GenerateInputServiceName(spec.Name), spec, obs.SyslogReceiverProtocolUDP))
+			ids = append(ids, udpID)
+		}
+		els = append(els, NewSyslogReceiverMeta(metaID, includePeerAddress, ids...))
 	case obs.ReceiverTypeHTTP:
 		el, id := source.NewHttpSource(base, resNames.GenerateInputServiceName(spec.Name), spec)
-		split, splitID := source.NewSplitTransform(base, id)
-		items, itemsID := source.NewItemsTransform(base, splitID)
+		els = append(els, el, tlsConfig)
+		if spec.Receiver.HTTP.Format == obs.HTTPReceiverFormatKubeAPIAudit {
+			split, splitID := source.NewSplitTransform(base, auditItemsPath, id)
+			items, itemsID := source.NewItemsTransform(base, auditItemsPath, splitID)
+			els = append(els,
+				split,
+				items,
+				NewLogSourceAndType(metaID, obs.AuditSourceKube, obs.InputTypeAudit, itemsID),
+			)
+			break
+		}
+		if path := spec.Receiver.HTTP.ItemsPath; path != "" {
+			var split, items generator.Element
+			split, id = source.NewSplitTransform(base, path, id)
+			items, id = source.NewItemsTransform(base, path, id)
+			els = append(els, split, items)
+		}
+		logType, logSource := observability.HTTPReceiverLogTypeAndSource(*spec.Receiver.HTTP)
+		els = append(els, NewLogSourceAndType(metaID, logSource, logType, id))
+	case obs.ReceiverTypeOTLP:
+		el, id := source.NewOTLPSource(base, resNames.GenerateInputServiceName(spec.Name), spec)
+		els = append(els, el, receiverTLS(base+".http", *spec.Receiver, secrets, op))
+		if spec.Receiver.OTLP != nil && spec.Receiver.OTLP.GRPCPort != nil {
+			els = append(els, receiverTLS(base+".grpc", *spec.Receiver, secrets, op))
+		}
+		els = append(els, NewOTLPReceiverMeta(metaID, id))
+	case obs.ReceiverTypeForward:
+		var tagNamespaces []obs.ForwardTagNamespace
+		if spec.Receiver.Forward != nil {
+			tagNamespaces = spec.Receiver.Forward.TagNamespaces
+		}
 		els = append(els,
-			el,
+			source.NewForwardSource(base, resNames.GenerateInputServiceName(spec.Name), spec),
 			tlsConfig,
-			split,
-			items,
-			NewLogSourceAndType(metaID, obs.AuditSourceKube, obs.InputTypeAudit, itemsID),
+			NewForwardReceiverMeta(metaID, tagNamespaces, base),
 		)
 	}
 	return els, []string{metaID}
 }
 
-func receiverTLS(id string, spec *obs.InputTLSSpec, secrets observability.Secrets, op generator.Options) generator.Element {
+// receiverTLS configures the server certificate of a receiver and the verification of client certificates
+// when its authentication specs a client CA
+func receiverTLS(id string, receiver obs.ReceiverSpec, secrets observability.Secrets, op generator.Options) generator.Element {
+	spec := receiver.TLS
 	if spec == nil {
 		return generator.Nil
 	}
@@ -51,6 +104,13 @@ func receiverTLS(id string, spec *obs.InputTLSSpec, secrets observability.Secret
 			KeyPassphrase: spec.KeyPassphrase,
 		},
 	}
-	template := tls.New(id, tlsSpec, secrets, op, generator.Option{Name: tls.Component, Value: "sources"}, generator.Option{Name: tls.IncludeEnabled, Value: ""})
-	return template
+	options := []generator.Option{
+		{Name: tls.Component, Value: "sources"},
+		{Name: tls.IncludeEnabled, Value: ""},
+	}
+	if receiver.Authentication != nil && receiver.Authentication.ClientCA != nil {
+		tlsSpec.CA = receiver.Authentication.ClientCA
+		options = append(options, generator.Option{Name: tls.VerifyCertificate, Value: ""})
+	}
+	return tls.New(id, tlsSpec, secrets, op, options...)
 }
@@ -145,6 +145,10 @@ func NewSource(input obs.InputSpec, collectorNS string, resNames factory.Forward
 		return els, ids
 	case obs.InputTypeReceiver:
 		return NewViaqReceiverSource(input, resNames, secrets, op)
+	case obs.InputTypeKubernetesEvents:
+		return NewKubernetesEventsSource(input, op)
+	case obs.InputTypeHostFiles:
+		return NewHostFilesSource(input)
 	}
 	return els, ids
 }
@@ -152,30 +156,49 @@ func NewSource(input obs.InputSpec, collectorNS string, resNames factory.Forward
 // NewContainerSource generates config elements and the id reference of this input and normalizes
 func NewContainerSource(spec obs.InputSpec, namespace, includes, excludes string, logType obs.InputType, logSource interface{}) ([]framework.Element, []string) {
 	base := helpers.MakeInputID(spec.Name, "container")
-	var selector *metav1.LabelSelector
+	var selector, namespaceSelector *metav1.LabelSelector
+	var streams []obs.ContainerStream
+	annotationPolicy := obs.PodAnnotationPolicyIgnore
 	if spec.Application != nil {
 		selector = spec.Application.Selector
+		namespaceSelector = spec.Application.NamespaceSelector
+		streams = spec.Application.Streams
+		if spec.Application.AnnotationPolicy != "" {
+			annotationPolicy = spec.Application.AnnotationPolicy
+		}
 	}
 	metaID := helpers.MakeID(base, "meta")
 	el := []framework.Element{
 		source.KubernetesLogs{
-			ComponentID:        base,
-			Desc:               "Logs from containers (including openshift containers)",
-			IncludePaths:       includes,
-			ExcludePaths:       excludes,
-			ExtraLabelSelector: source.LabelSelectorFrom(selector),
+			ComponentID:                 base,
+			Desc:                        "Logs from containers (including openshift containers)",
+			IncludePaths:                includes,
+			ExcludePaths:                excludes,
+			ExtraLabelSelector:          source.LabelSelectorFrom(selector),
+			ExtraNamespaceLabelSelector: source.LabelSelectorFrom(namespaceSelector),
 		},
 		NewLogSourceAndType(metaID, logSource, logType, base),
 	}
-	inputID := metaID
+	inputIDs := []string{metaID}
+	if annotationPolicy != obs.PodAnnotationPolicyIgnore {
+		annotationsID := helpers.MakeID(base, "annotations")
+		el = append(el, NewPodAnnotationPolicy(annotationsID, annotationPolicy, inputIDs...))
+		inputIDs = []string{annotationsID}
+	}
+	if len(streams) > 0 {
+		streamsID := helpers.MakeID(base, "streams")
+		el = append(el, NewContainerStreams(streamsID, streams, inputIDs...))
+		inputIDs = []string{streamsID}
+	}
 	//TODO: DETERMINE IF key field is correct and actually works
-	if threshold, hasPolicy := internalobs.MaxRecordsPerSecond(spec); hasPolicy {
+	if _, hasPolicy := internalobs.MaxRecordsPerSecond(spec); hasPolicy {
 		throttleID := helpers.MakeID(base, "throttle")
-		inputID = throttleID
-		el = append(el, AddThrottleToInput(throttleID, metaID, threshold)...)
+		var throttle []framework.Element
+		throttle, inputIDs = AddThrottleToInput(throttleID, inputIDs[0], *spec.Application.Tuning.RateLimitPerContainer)
+		el = append(el, throttle...)
 	}
 
-	return el, []string{inputID}
+	return el, inputIDs
 }
 
 // pruneInfraNS returns a pruned infra namespace list depending on which infra namespaces were included
@@ -3,6 +3,7 @@ package input
 import (
 	"fmt"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -240,6 +241,47 @@ var _ = Describe("inputs", func() {
 		},
 			"application_with_matchLabels.toml",
 		),
+		Entry("with an application that specs a namespace selector and streams", obs.InputSpec{
+			Name: "my-app",
+			Type: obs.InputTypeApplication,
+			Application: &obs.Application{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"logging.example.com/forward": "true",
+					},
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "env", Operator: metav1.LabelSelectorOpIn, Values: []string{"prod", "dev"}},
+					},
+				},
+				Streams: []obs.ContainerStream{obs.ContainerStreamStderr},
+				Tuning: &obs.ContainerInputTuningSpec{
+					RateLimitPerContainer: &obs.LimitSpec{
+						MaxRecordsPerSecond: 100,
+					},
+				},
+			},
+		},
+			"application_with_namespace_selector_streams.toml",
+		),
+		Entry("with an application that honors pod annotations to opt out", obs.InputSpec{
+			Name: "my-app",
+			Type: obs.InputTypeApplication,
+			Application: &obs.Application{
+				AnnotationPolicy: obs.PodAnnotationPolicyOptOut,
+			},
+		},
+			"application_with_annotation_opt_out.toml",
+		),
+		Entry("with an application that honors pod annotations to opt in and specs streams", obs.InputSpec{
+			Name: "my-app",
+			Type: obs.InputTypeApplication,
+			Application: &obs.Application{
+				AnnotationPolicy: obs.PodAnnotationPolicyOptIn,
+				Streams:          []obs.ContainerStream{obs.ContainerStreamStdout},
+			},
+		},
+			"application_with_annotation_opt_in.toml",
+		),
 		Entry("with an infrastructure input should generate a container and journal source", obs.InputSpec{
 			Name: string(obs.InputTypeInfrastructure),
 			Type: obs.InputTypeInfrastructure,
@@ -271,6 +313,45 @@ var _ = Describe("inputs", func() {
 		},
 			"infrastructure_journal.toml",
 		),
+		Entry("with an infrastructure input for node with journal filters should generate a filtered journal source", obs.InputSpec{
+			Name: "myinfra",
+			Type: obs.InputTypeInfrastructure,
+			Infrastructure: &obs.Infrastructure{
+				Sources: []obs.InfrastructureSource{obs.InfrastructureSourceNode},
+				Journal: &obs.Journal{
+					IncludeUnits:    []obs.SystemdUnit{"kubelet.service", "crio"},
+					ExcludeUnits:    []obs.SystemdUnit{"systemd-journald.service"},
+					MinimumPriority: obs.JournalPriorityWarning,
+					CurrentBootOnly: utils.GetPtr(false),
+					Matches: map[string][]string{
+						"_TRANSPORT": {"journal", "stdout"},
+					},
+				},
+			},
+		},
+			"infrastructure_journal_filters.toml",
+		),
+		Entry("with a hostFiles input should generate a file source", obs.InputSpec{
+			Name: "gpu",
+			Type: obs.InputTypeHostFiles,
+			HostFiles: &obs.HostFiles{
+				Includes: []string{"/var/log/nvidia/*.log"},
+			},
+		},
+			"host_files.toml",
+		),
+		Entry("with a hostFiles input with excludes, multiline and read from beginning should generate a file source", obs.InputSpec{
+			Name: "gpu",
+			Type: obs.InputTypeHostFiles,
+			HostFiles: &obs.HostFiles{
+				Includes:              []string{"/var/log/nvidia/*.log", "/var/log/storage/**/*.log"},
+				Excludes:              []string{"/var/log/nvidia/debug.log"},
+				MultilineStartPattern: `^\d{4}-\d{2}-\d{2}`,
+				ReadFromBeginning:     true,
+			},
+		},
+			"host_files_multiline.toml",
+		),
 		Entry("with an audit input should generate file sources", obs.InputSpec{
 			Name:  string(obs.InputTypeAudit),
 			Type:  obs.InputTypeAudit,
@@ -343,6 +424,164 @@ var _ = Describe("inputs", func() {
 		},
 			"receiver_http_audit.toml",
 		),
+		Entry("with a json http receiver input should split the items of a batch", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Port: 12345,
+				HTTP: &obs.HTTPReceiver{
+					Format:    obs.HTTPReceiverFormatJSON,
+					ItemsPath: ".records",
+					LogType:   obs.InputTypeInfrastructure,
+					LogSource: "functions",
+				},
+			},
+		},
+			"receiver_http_json.toml",
+		),
+		Entry("with a ndjson http receiver input should generate a newline delimited json source", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Port: 12345,
+				HTTP: &obs.HTTPReceiver{
+					Format: obs.HTTPReceiverFormatNDJSON,
+				},
+			},
+		},
+			"receiver_http_ndjson.toml",
+		),
+		Entry("with a text http receiver input should generate a newline delimited text source", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Port: 12345,
+				HTTP: &obs.HTTPReceiver{
+					Format: obs.HTTPReceiverFormatText,
+				},
+			},
+		},
+			"receiver_http_text.toml",
+		),
+		Entry("with an otlp receiver input should generate an opentelemetry source listening for http and grpc", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeOTLP,
+				Port: 12345,
+				OTLP: &obs.OTLPReceiver{
+					GRPCPort: utils.GetPtr(int32(12346)),
+				},
+				TLS: &obs.InputTLSSpec{
+					Certificate: &obs.ValueReference{
+						Key:        constants.ClientCertKey,
+						SecretName: secretName,
+					},
+					Key: &obs.SecretReference{
+						Key:        constants.ClientPrivateKey,
+						SecretName: secretName,
+					},
+				},
+			},
+		},
+			"receiver_otlp.toml",
+		),
+		Entry("with an otlp receiver input without grpc should bind grpc to the loopback interface", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeOTLP,
+				Port: 12345,
+			},
+		},
+			"receiver_otlp_http.toml",
+		),
+		Entry("with a forward receiver input should generate a fluent source", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeForward,
+				Port: 24224,
+				Forward: &obs.ForwardReceiver{
+					TagNamespaces: []obs.ForwardTagNamespace{
+						{Tag: `^payments\.`, Namespace: "payments"},
+						{Tag: `^edge\.`, Namespace: "edge-agents"},
+						{Tag: `^team's\.`, Namespace: "teams"},
+					},
+				},
+				TLS: &obs.InputTLSSpec{
+					Certificate: &obs.ValueReference{
+						Key:        constants.ClientCertKey,
+						SecretName: secretName,
+					},
+					Key: &obs.SecretReference{
+						Key:        constants.ClientPrivateKey,
+						SecretName: secretName,
+					},
+				},
+			},
+		},
+			"receiver_forward.toml",
+		),
+		Entry("with an http receiver input which authenticates clients with a token and certificates", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Port: 12345,
+				HTTP: &obs.HTTPReceiver{
+					Format: obs.HTTPReceiverFormatKubeAPIAudit,
+				},
+				TLS: &obs.InputTLSSpec{
+					Certificate: &obs.ValueReference{
+						Key:        constants.ClientCertKey,
+						SecretName: secretName,
+					},
+					Key: &obs.SecretReference{
+						Key:        constants.ClientPrivateKey,
+						SecretName: secretName,
+					},
+				},
+				Authentication: &obs.ReceiverAuthentication{
+					ClientCA: &obs.ValueReference{
+						Key:           "ca-bundle.crt",
+						ConfigMapName: "client-ca",
+					},
+					Token: &obs.SecretReference{
+						Key:        "token",
+						SecretName: "receiver-auth",
+					},
+				},
+			},
+		},
+			"receiver_http_auth_token.toml",
+		),
+		Entry("with an http receiver input which authenticates clients with basic authentication", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Port: 12345,
+				HTTP: &obs.HTTPReceiver{
+					Format: obs.HTTPReceiverFormatNDJSON,
+				},
+				Authentication: &obs.ReceiverAuthentication{
+					Username: &obs.SecretReference{
+						Key:        "username",
+						SecretName: "receiver-auth",
+					},
+					Password: &obs.SecretReference{
+						Key:        "password",
+						SecretName: "receiver-auth",
+					},
+				},
+			},
+		},
+			"receiver_http_auth_basic.toml",
+		),
 		Entry("with a syslog receiver input should generate VIAQ syslog receiver", obs.InputSpec{
 			Type: obs.InputTypeReceiver,
 			Name: "myreceiver",
@@ -395,5 +634,62 @@ var _ = Describe("inputs", func() {
 		},
 			"receiver_syslog_tls_from_configmap.toml",
 		),
+		Entry("with a syslog receiver listening for tcp and udp", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeSyslog,
+				Port: 12345,
+				Syslog: &obs.SyslogReceiver{
+					Protocol:           obs.SyslogReceiverProtocolBoth,
+					MaxMessageLength:   utils.GetPtr(int64(8192)),
+					IncludePeerAddress: true,
+				},
+			},
+		},
+			"receiver_syslog_tcp_udp.toml",
+		),
+		Entry("with a syslog receiver listening for udp", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeSyslog,
+				Port: 12345,
+				Syslog: &obs.SyslogReceiver{
+					Protocol: obs.SyslogReceiverProtocolUDP,
+				},
+			},
+		},
+			"receiver_syslog_udp.toml",
+		),
+	)
+
+	DescribeTable("#NewSource for kubernetes events", func(events obs.KubernetesEvents, expFile string) {
+		exp, err := tomlContent.ReadFile(expFile)
+		if err != nil {
+			Fail(fmt.Sprintf("Error reading the file %q with exp config: %v", expFile, err))
+		}
+		clf := obs.ClusterLogForwarder{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      constants.SingletonName,
+				Namespace: constants.OpenshiftNS,
+			},
+		}
+		input := obs.InputSpec{
+			Name:   "myevents",
+			Type:   obs.InputTypeKubernetesEvents,
+			Events: &events,
+		}
+		op := framework.Options{framework.OptionServiceAccountTokenSecretName: "logcollector-token"}
+		conf, _ := NewSource(input, constants.OpenshiftNS, *factory.ResourceNames(clf), secrets, op)
+		Expect(string(exp)).To(EqualConfigFrom(conf))
+	},
+		Entry("should collect the events of all namespaces", obs.KubernetesEvents{}, "events.toml"),
+		Entry("should collect the filtered events", obs.KubernetesEvents{
+			Namespaces:          []string{"my-app", "my-db"},
+			InvolvedObjectKinds: []string{"Pod", "Deployment"},
+			Types:               []obs.KubernetesEventType{obs.KubernetesEventTypeWarning},
+			PollIntervalSeconds: utils.GetPtr(int64(30)),
+		}, "events_with_filters.toml"),
 	)
 })
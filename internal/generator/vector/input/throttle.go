@@ -1,20 +1,23 @@
 package input
 
 import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/normalize"
 )
 
 const (
-	perContainerLimitKeyField = `"{{ file }}"`
+	perContainerLimitKeyField = `{{ file }}`
 )
 
-func AddThrottleToInput(id, input string, maxRecordsPerSec int64) []Element {
+// AddThrottleToInput returns the elements which limit the records of each container and the ids of the
+// elements that forward the records
+func AddThrottleToInput(id, input string, limit obs.LimitSpec) ([]Element, []string) {
 	throttleKey := perContainerLimitKeyField
 	return normalize.NewThrottle(
 		id,
 		[]string{input},
-		maxRecordsPerSec,
+		limit,
 		throttleKey,
 	)
 }
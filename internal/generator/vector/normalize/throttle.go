@@ -1,29 +1,74 @@
 package normalize
 
 import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	commontemplate "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/template"
 )
 
+const defaultWindowSecs = int64(1)
+
 type Throttle struct {
+	ComponentID     string
+	Desc            string
+	Inputs          string
+	Threshold       int64
+	WindowSecs      int64
+	KeyField        string
+	EmitPerKeyEvent bool
+}
+
+// Discard is a sink that consumes the records that are within a limit which is only counting the excess records
+type Discard struct {
 	ComponentID string
-	Desc        string
 	Inputs      string
-	Threshold   int64
-	KeyField    string
 }
 
-func NewThrottle(id string, inputs []string, threshhold int64, throttleKey string) []framework.Element {
+// NewThrottle returns the elements which rate limit the records of the inputs and the ids of the elements
+// that forward the records. The defaultKey is the template of the throttle key when the limit does not define one
+func NewThrottle(id string, inputs []string, limit obs.LimitSpec, defaultKey string) ([]framework.Element, []string) {
 	el := []framework.Element{}
 
+	throttleInputs := inputs
+	key := defaultKey
+	if limit.KeyTemplate != "" {
+		keyID := helpers.MakeID(id, "key")
+		el = append(el, commontemplate.TemplateRemap(keyID, inputs, limit.KeyTemplate, keyID, "Rate Limit Key"))
+		throttleInputs = []string{keyID}
+		key = fmt.Sprintf("{{ _internal.%s }}", keyID)
+	}
+	if key != "" {
+		if limit.MetricLabel != "" {
+			key = limit.MetricLabel + "=" + key
+		}
+		key = fmt.Sprintf("%q", key)
+	}
+	windowSecs := defaultWindowSecs
+	if limit.WindowSeconds != nil {
+		windowSecs = *limit.WindowSeconds
+	}
+
 	el = append(el, Throttle{
-		ComponentID: id,
-		Inputs:      helpers.MakeInputs(inputs...),
-		Threshold:   threshhold,
-		KeyField:    throttleKey,
+		ComponentID:     id,
+		Inputs:          helpers.MakeInputs(throttleInputs...),
+		Threshold:       limit.MaxRecordsPerSecond * windowSecs,
+		WindowSecs:      windowSecs,
+		KeyField:        key,
+		EmitPerKeyEvent: limit.MetricLabel != "",
 	})
 
-	return el
+	if limit.Action == obs.LimitActionCount {
+		// Records are forwarded around the throttle which only counts the excess
+		el = append(el, Discard{
+			ComponentID: helpers.MakeID(id, "count"),
+			Inputs:      helpers.MakeInputs(id),
+		})
+		return el, throttleInputs
+	}
+	return el, []string{id}
 }
 
 func (t Throttle) Name() string {
@@ -39,11 +84,29 @@ func (t Throttle) Template() string {
 [transforms.{{.ComponentID}}]
 type = "throttle"
 inputs = {{.Inputs}}
-window_secs = 1
+window_secs = {{.WindowSecs}}
 threshold = {{.Threshold}}
 {{- if .KeyField}}
 key_field = {{ .KeyField }}
 {{- end}}
+{{- if .EmitPerKeyEvent}}
+internal_metrics.emit_events_discarded_per_key = true
+{{- end}}
+{{end}}
+`
+}
+
+func (d Discard) Name() string {
+	return "discardTemplate"
+}
+
+func (d Discard) Template() string {
+	return `
+{{define "discardTemplate" -}}
+[sinks.{{.ComponentID}}]
+type = "blackhole"
+inputs = {{.Inputs}}
+print_interval_secs = 0
 {{end}}
 `
 }
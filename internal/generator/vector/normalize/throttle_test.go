@@ -0,0 +1,74 @@
+package normalize
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("#NewThrottle", func() {
+
+	It("should throttle all records using the default window", func() {
+		els, ids := NewThrottle("my_throttle", []string{"application"}, obs.LimitSpec{MaxRecordsPerSecond: 100}, "")
+		Expect(ids).To(Equal([]string{"my_throttle"}))
+		Expect(`
+[transforms.my_throttle]
+type = "throttle"
+inputs = ["application"]
+window_secs = 1
+threshold = 100
+`).To(EqualConfigFrom(els))
+	})
+
+	It("should throttle each partition of the key template over the window", func() {
+		limit := obs.LimitSpec{
+			MaxRecordsPerSecond: 100,
+			KeyTemplate:         `{.kubernetes.labels.team||.kubernetes.namespace_name||"none"}`,
+			WindowSeconds:       utils.GetPtr(int64(10)),
+			MetricLabel:         "team",
+		}
+		els, ids := NewThrottle("my_throttle", []string{"application"}, limit, "{{ file }}")
+		Expect(ids).To(Equal([]string{"my_throttle"}))
+		Expect(`
+# Rate Limit Key
+[transforms.my_throttle_key]
+type = "remap"
+inputs = ["application"]
+source = '''
+._internal.my_throttle_key = to_string!(._internal.kubernetes.labels.team||.kubernetes.namespace_name||"none")
+'''
+
+[transforms.my_throttle]
+type = "throttle"
+inputs = ["my_throttle_key"]
+window_secs = 10
+threshold = 1000
+key_field = "team={{ _internal.my_throttle_key }}"
+internal_metrics.emit_events_discarded_per_key = true
+`).To(EqualConfigFrom(els))
+	})
+
+	It("should forward all records and only count the excess when the action is count", func() {
+		limit := obs.LimitSpec{
+			MaxRecordsPerSecond: 50,
+			Action:              obs.LimitActionCount,
+		}
+		els, ids := NewThrottle("my_throttle", []string{"application"}, limit, "{{ file }}")
+		Expect(ids).To(Equal([]string{"application"}), "expected records to bypass the throttle")
+		Expect(`
+[transforms.my_throttle]
+type = "throttle"
+inputs = ["application"]
+window_secs = 1
+threshold = 50
+key_field = "{{ file }}"
+
+[sinks.my_throttle_count]
+type = "blackhole"
+inputs = ["my_throttle"]
+print_interval_secs = 0
+`).To(EqualConfigFrom(els))
+	})
+})
@@ -0,0 +1,117 @@
+package azurelogsingestion
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/api/observability"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	genhelper "github.com/openshift/cluster-logging-operator/internal/generator/helpers"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/tls"
+)
+
+const (
+	credentialKindClientSecret     = "client_secret_credential"
+	credentialKindWorkloadIdentity = "workload_identity"
+)
+
+type AzureLogsIngestion struct {
+	Desc           string
+	ComponentID    string
+	Inputs         string
+	Endpoint       string
+	DCRImmutableID string
+	StreamName     string
+}
+
+func (a AzureLogsIngestion) Name() string {
+	return "azureLogsIngestionTemplate"
+}
+
+func (a AzureLogsIngestion) Template() string {
+	return `{{define "` + a.Name() + `" -}}
+{{if .Desc -}}
+# {{.Desc}}
+{{end -}}
+[sinks.{{.ComponentID}}]
+type = "azure_logs_ingestion"
+inputs = {{.Inputs}}
+endpoint = "{{.Endpoint}}"
+dcr_immutable_id = "{{.DCRImmutableID}}"
+stream_name = "{{.StreamName}}"
+{{- end}}
+`
+}
+
+// Auth is the Entra ID client credential configuration of the sink. The federated token used by
+// workload identity and the authority host are provided to the collector by environment variables
+type Auth struct {
+	ComponentID    string
+	CredentialKind string
+	TenantID       string
+	ClientID       string
+	ClientSecret   string
+}
+
+func (a Auth) Name() string {
+	return "azureLogsIngestionAuthTemplate"
+}
+
+func (a Auth) Template() string {
+	return `{{define "` + a.Name() + `" -}}
+[sinks.{{.ComponentID}}.auth]
+azure_credential_kind = "{{.CredentialKind}}"
+azure_tenant_id = "{{.TenantID}}"
+azure_client_id = "{{.ClientID}}"
+{{- if .ClientSecret }}
+azure_client_secret = "{{.ClientSecret}}"
+{{- end }}
+{{- end}}
+`
+}
+
+func New(id string, o obs.OutputSpec, inputs []string, secrets observability.Secrets, strategy common.ConfigStrategy, op Options) []Element {
+	if genhelper.IsDebugOutput(op) {
+		return []Element{
+			Debug(id, vectorhelpers.MakeInputs(inputs...)),
+		}
+	}
+	ali := o.AzureLogsIngestion
+	sink := AzureLogsIngestion{
+		Desc:           "Azure Logs Ingestion",
+		ComponentID:    id,
+		Inputs:         vectorhelpers.MakeInputs(inputs...),
+		Endpoint:       ali.URL,
+		DCRImmutableID: ali.DCRImmutableID,
+		StreamName:     ali.StreamName,
+	}
+	return []Element{
+		sink,
+		authConfig(id, ali.Authentication),
+		common.NewEncoding(id, ""),
+		common.NewAcknowledgments(id, strategy),
+		common.NewBatch(id, strategy),
+		common.NewBuffer(id, strategy),
+		common.NewRequest(id, strategy),
+		tls.New(id, o.TLS, secrets, op),
+	}
+}
+
+func authConfig(id string, spec *obs.AzureLogsIngestionAuthentication) Element {
+	if spec == nil {
+		return Nil
+	}
+	auth := Auth{
+		ComponentID:    id,
+		CredentialKind: credentialKindClientSecret,
+		TenantID:       vectorhelpers.SecretFrom(&spec.TenantID),
+		ClientID:       vectorhelpers.SecretFrom(&spec.ClientID),
+	}
+	if spec.Type == obs.AzureLogsIngestionAuthTypeWorkloadIdentity {
+		auth.CredentialKind = credentialKindWorkloadIdentity
+	} else {
+		auth.ClientSecret = vectorhelpers.SecretFrom(spec.ClientSecret)
+	}
+	return auth
+}
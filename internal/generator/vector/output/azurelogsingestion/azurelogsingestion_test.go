@@ -0,0 +1,112 @@
+package azurelogsingestion_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/azurelogsingestion"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/test/helpers/outputs/adapter/fake"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("Generating vector config for Azure Logs Ingestion output", func() {
+
+	const (
+		secretName   = "azure-logs-ingestion-secret"
+		tenantIDKey  = "tenant_id"
+		clientIDKey  = "client_id"
+		clientSecret = "client_secret"
+	)
+
+	var (
+		initOutput = func() obs.OutputSpec {
+			return obs.OutputSpec{
+				Type: obs.OutputTypeAzureLogsIngestion,
+				Name: "azure_logs_ingestion",
+				AzureLogsIngestion: &obs.AzureLogsIngestion{
+					URLSpec: obs.URLSpec{
+						URL: "https://my-dce-5kyl.eastus-1.ingest.monitor.azure.com",
+					},
+					DCRImmutableID: "dcr-00000000000000000000000000000000",
+					StreamName:     "Custom-MyTable_CL",
+					Authentication: &obs.AzureLogsIngestionAuthentication{
+						Type:     obs.AzureLogsIngestionAuthTypeClientSecret,
+						TenantID: obs.SecretReference{Key: tenantIDKey, SecretName: secretName},
+						ClientID: obs.SecretReference{Key: clientIDKey, SecretName: secretName},
+						ClientSecret: &obs.SecretReference{
+							Key:        clientSecret,
+							SecretName: secretName,
+						},
+					},
+				},
+			}
+		}
+
+		secrets = map[string]*corev1.Secret{
+			secretName: {
+				Data: map[string][]byte{
+					tenantIDKey:          []byte("a-tenant"),
+					clientIDKey:          []byte("a-client"),
+					clientSecret:         []byte("a-secret"),
+					constants.Passphrase: []byte("foo"),
+				},
+			},
+		}
+	)
+
+	DescribeTable("should generate valid config", func(visit func(spec *obs.OutputSpec), tune bool, expFile string) {
+		exp, err := tomlContent.ReadFile(expFile)
+		if err != nil {
+			Fail(fmt.Sprintf("Error reading the file %q with exp config: %v", expFile, err))
+		}
+		outputSpec := initOutput()
+		if visit != nil {
+			visit(&outputSpec)
+		}
+		var adapter common.ConfigStrategy
+		if tune {
+			adapter = fake.NewOutput(outputSpec, secrets, framework.NoOptions)
+		}
+		conf := New(outputSpec.Name, outputSpec, []string{"pipelineName"}, secrets, adapter, framework.NoOptions)
+		Expect(string(exp)).To(EqualConfigFrom(conf))
+	},
+		Entry("with client secret authentication", nil, false, "ali_client_secret.toml"),
+		Entry("with workload identity authentication", func(spec *obs.OutputSpec) {
+			auth := spec.AzureLogsIngestion.Authentication
+			auth.Type = obs.AzureLogsIngestionAuthTypeWorkloadIdentity
+			auth.ClientSecret = nil
+			auth.Token = &obs.BearerToken{
+				From: obs.BearerTokenFromServiceAccount,
+			}
+		}, false, "ali_workload_identity.toml"),
+		Entry("when tuning is spec'd", func(spec *obs.OutputSpec) {
+			spec.AzureLogsIngestion.Tuning = &obs.BaseOutputTuningSpec{
+				DeliveryMode:     obs.DeliveryModeAtLeastOnce,
+				MaxWrite:         utils.GetPtr(resource.MustParse("10M")),
+				MaxRetryDuration: utils.GetPtr(time.Duration(35)),
+				MinRetryDuration: utils.GetPtr(time.Duration(20)),
+			}
+		}, true, "ali_tuning.toml"),
+		Entry("when TLS is spec'd", func(spec *obs.OutputSpec) {
+			spec.TLS = &obs.OutputTLSSpec{
+				InsecureSkipVerify: true,
+				TLSSpec: obs.TLSSpec{
+					CA: &obs.ValueReference{
+						Key:        constants.TrustedCABundleKey,
+						SecretName: secretName,
+					},
+				},
+			}
+		}, false, "ali_tls.toml"),
+	)
+})
@@ -5,6 +5,9 @@ import (
 )
 
 type Auth struct {
+	Strategy        OptionalPair
+	Region          OptionalPair
+	Service         OptionalPair
 	KeyID           OptionalPair
 	KeySecret       OptionalPair
 	CredentialsPath OptionalPair
@@ -12,6 +15,9 @@ type Auth struct {
 
 func NewAuth() Auth {
 	return Auth{
+		Strategy:        NewOptionalPair("auth.strategy", nil),
+		Region:          NewOptionalPair("auth.region", nil),
+		Service:         NewOptionalPair("auth.service", nil),
 		KeyID:           NewOptionalPair("auth.access_key_id", nil),
 		KeySecret:       NewOptionalPair("auth.secret_access_key", nil),
 		CredentialsPath: NewOptionalPair("auth.credentials_file", nil),
@@ -24,6 +30,9 @@ func (a Auth) Name() string {
 
 func (a Auth) Template() string {
 	return `{{define "` + a.Name() + `" -}}
+{{.Strategy}}
+{{.Region}}
+{{.Service}}
 {{.KeyID}}
 {{.KeySecret}}
 {{.CredentialsPath}}
@@ -107,13 +107,15 @@ func sink(id string, o obs.OutputSpec, inputs []string, secrets observability.Se
 		Inputs:         vectorhelpers.MakeInputs(inputs...),
 		Region:         region,
 		GroupName:      groupName,
-		SecurityConfig: authConfig(o.Cloudwatch.Authentication, secrets),
+		SecurityConfig: AuthConfig(o.Cloudwatch.Authentication, secrets),
 		EndpointConfig: endpointConfig(o.Cloudwatch),
 		RootMixin:      common.NewRootMixin("none"),
 	}
 }
 
-func authConfig(auth *obs.CloudwatchAuthentication, secrets observability.Secrets) Element {
+// AuthConfig generates the AWS authentication of a sink. Only static access keys are configured in the sink
+// because IAM role authentication is provided to the collector by web identity env vars
+func AuthConfig(auth *obs.CloudwatchAuthentication, secrets observability.Secrets) Auth {
 	authConfig := NewAuth()
 	if auth != nil && auth.Type == obs.CloudwatchAuthTypeAccessKey {
 		authConfig.KeyID.Value = vectorhelpers.SecretFrom(&auth.AWSAccessKey.KeyId)
@@ -122,6 +124,17 @@ func authConfig(auth *obs.CloudwatchAuthentication, secrets observability.Secret
 	return authConfig
 }
 
+// DefaultSigV4ServiceName is the signing name of Amazon OpenSearch Service used when none is specified
+const DefaultSigV4ServiceName = "es"
+
+// SigV4AuthConfig generates the AWS authentication of a sink that signs the requests to a non-CloudWatch
+// service using AWS SigV4
+func SigV4AuthConfig(auth *obs.AWSAuthentication, secrets observability.Secrets) Auth {
+	authConfig := AuthConfig(&auth.CloudwatchAuthentication, secrets)
+	authConfig.Strategy.Value = "aws"
+	return authConfig
+}
+
 func endpointConfig(cw *obs.Cloudwatch) Element {
 	if cw == nil {
 		return Endpoint{}
@@ -14,7 +14,7 @@ func NewBatch(id string, s ConfigStrategy) Batch {
 		ComponentID: id,
 		MaxBytes:    helpers.NewOptionalPair("max_bytes", nil),
 		MaxEvents:   helpers.NewOptionalPair("max_events", nil),
-		TimeoutSec:  helpers.NewOptionalPair("timeout_sec", nil),
+		TimeoutSec:  helpers.NewOptionalPair("timeout_secs", nil),
 	}
 	if s != nil {
 		b = s.VisitBatch(b)
@@ -33,3 +33,12 @@ func NewVectorSecret() VectorSecret {
 	}
 	return secret
 }
+
+// NewVectorConfigMap loads values from the files of the configmaps mounted into the collector
+func NewVectorConfigMap() VectorSecret {
+	return VectorSecret{
+		ComponentID: helpers.VectorConfigMapID,
+		Desc:        "Load values from configmaps",
+		BasePath:    constants.ConfigMapBaseDir,
+	}
+}
@@ -45,9 +45,36 @@ func TemplateRemap(componentID string, inputs []string, userTemplate, field, des
 	}
 }
 
+var (
+	// internalLabelsReplacer references the labels saved in the internal context before they are dedotted
+	internalLabelsReplacer = strings.NewReplacer(
+		".kubernetes.labels", "._internal.kubernetes.labels",
+		".kubernetes.namespace_labels", "._internal.kubernetes.namespace_labels",
+		".openshift.labels", "._internal.openshift.labels",
+	)
+)
+
+// InternalFieldPath converts a field path of the record to the path of its value in the internal context when
+// the value is changed before forwarding (e.g. dedotted labels)
+// Example: .kubernetes.labels."app.kubernetes.io/name" -> ._internal.kubernetes.labels."app.kubernetes.io/name"
+func InternalFieldPath(path string) string {
+	return internalLabelsReplacer.Replace(path)
+}
+
 // TransformUserTemplateToVRL converts the user entered template to VRL compatible syntax
 // Example: foo-{.log_type||"none"} -> "foo-" + to_string!(.log_type||"none")
 func TransformUserTemplateToVRL(userTemplate string) string {
+	return transformUserTemplate(userTemplate, internalLabelsReplacer)
+}
+
+// TransformUserTemplateToRecordVRL converts the user entered template to VRL compatible syntax which references
+// the fields of the record as is. It is meant for templates evaluated before the labels of the record are dedotted
+// Example: foo-{.kubernetes.labels.app||"none"} -> "foo-" + to_string!(.kubernetes.labels.app||"none")
+func TransformUserTemplateToRecordVRL(userTemplate string) string {
+	return transformUserTemplate(userTemplate, strings.NewReplacer())
+}
+
+func transformUserTemplate(userTemplate string, replacer *strings.Replacer) string {
 	// Finds and replaces expressions defined in `{}` with to_string!()
 	replacedUserTemplate := ReplaceBracketWithToString(userTemplate, "to_string!(%s)")
 
@@ -67,11 +94,7 @@ func TransformUserTemplateToVRL(userTemplate string) string {
 		}
 
 		// Append the to_string!() group and replace any labels with values from internal context
-		result = append(result, strings.NewReplacer(
-			".kubernetes.labels", "._internal.kubernetes.labels",
-			".kubernetes.namespace_labels", "._internal.kubernetes.namespace_labels",
-			".openshift.labels", "._internal.openshift.labels",
-		).Replace(replacedUserTemplate[match[0]:match[1]]))
+		result = append(result, replacer.Replace(replacedUserTemplate[match[0]:match[1]]))
 		lastIndex = match[1]
 	}
 	// Append the remaining part of the string after the last match making sure it isn't the empty string
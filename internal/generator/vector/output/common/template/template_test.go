@@ -18,4 +18,11 @@ var _ = Describe("Vector Output Template", func() {
 		Entry("should only add quotes and not transform template if using only a static value", `"foobar-myindex"`, `foobar-myindex`),
 		Entry("should transform template if only a dynamic value is defined", `to_string!(.foo.bar||"missing")`, `{.foo.bar||"missing"}`),
 	)
+
+	DescribeTable("transforms template syntax to VRL compatible string referencing the record", func(expVRL, template string) {
+		Expect(TransformUserTemplateToRecordVRL(template)).To(EqualTrimLines(expVRL))
+	},
+		Entry("should not reference the internal labels", `"app-" + to_string!(.kubernetes.labels.app||"none")`, `app-{.kubernetes.labels.app||"none"}`),
+		Entry("should only add quotes if using only a static value", `"production"`, `production`),
+	)
 })
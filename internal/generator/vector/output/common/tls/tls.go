@@ -12,6 +12,8 @@ import (
 const (
 	Component      = "component"
 	IncludeEnabled = "IncludeEnabled"
+	// VerifyCertificate requires the peer to present a certificate signed by the CA
+	VerifyCertificate = "VerifyCertificate"
 )
 
 var (
@@ -25,6 +27,7 @@ type TLSConf struct {
 	Enabled            typehelpers.OptionalPair
 	NeedsEnabled       bool
 	InsecureSkipVerify bool
+	VerifyCertificate  bool
 	TlsMinVersion      string
 	CipherSuites       string
 	CAFilePath         string
@@ -50,6 +53,10 @@ func New(id string, spec *obs.OutputTLSSpec, secrets observability.Secrets, op f
 		conf.Enabled = typehelpers.NewOptionalPair("enabled", true)
 	}
 
+	if _, found := framework.HasOption(VerifyCertificate, options); found && spec != nil {
+		conf.VerifyCertificate = true
+	}
+
 	if spec != nil {
 		conf.CAFilePath = ValuePath(spec.CA)
 		conf.CertPath = ValuePath(spec.Certificate)
@@ -114,6 +121,9 @@ ciphersuites = "{{ .CipherSuites }}"
 verify_certificate = false
 verify_hostname = false
 {{- end }}
+{{- if .VerifyCertificate }}
+verify_certificate = true
+{{- end }}
 {{- if and .KeyPath .CertPath }}
 key_file = {{ .KeyPath }}
 crt_file = {{ .CertPath }}
@@ -1,9 +1,13 @@
 package elasticsearch
 
 import (
+	"fmt"
+	"strings"
+
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/api/observability"
 	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/cloudwatch"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/auth"
 	commontemplate "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/template"
@@ -14,14 +18,29 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 )
 
+const (
+	defaultDataStreamType      = "logs"
+	defaultDataStreamDataset   = "generic"
+	defaultDataStreamNamespace = "default"
+)
+
+// opensearchServerlessServiceName is the AWS signing name of Amazon OpenSearch Serverless
+const opensearchServerlessServiceName = "aoss"
+
 type Elasticsearch struct {
 	IDKey       genhelper.OptionalPair
 	Desc        string
 	ComponentID string
 	Inputs      string
 	Index       string
+	DataStream  string
+	Pipeline    genhelper.OptionalPair
 	Endpoint    string
 	Version     int
+
+	SecurityConfig        Element
+	AWSRegion             genhelper.OptionalPair
+	OpensearchServiceType genhelper.OptionalPair
 	common.RootMixin
 }
 
@@ -36,8 +55,20 @@ type = "elasticsearch"
 inputs = {{.Inputs}}
 endpoints = ["{{.Endpoint}}"]
 {{.IDKey}}
+{{- if .DataStream }}
+mode = "data_stream"
+data_stream.type = "{{"{{"}} _internal.{{.DataStream}}_type {{"}}"}}"
+data_stream.dataset = "{{"{{"}} _internal.{{.DataStream}}_dataset {{"}}"}}"
+data_stream.namespace = "{{"{{"}} _internal.{{.DataStream}}_namespace {{"}}"}}"
+data_stream.auto_routing = false
+{{- else }}
 bulk.index = "{{"{{"}} _internal.{{.Index}} {{"}}"}}"
+{{- end }}
 bulk.action = "create"
+{{.Pipeline}}
+{{compose_one .SecurityConfig}}
+{{.AWSRegion}}
+{{.OpensearchServiceType}}
 {{.Compression}}
 {{- if ne .Version 0 }}
 api_version = "v{{ .Version }}"
@@ -55,53 +86,128 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets observability.Sec
 			Debug(id, helpers.MakeInputs(inputs...)),
 		}
 	}
-	componentID := helpers.MakeID(id, "index")
+	es := o.Elasticsearch
 	outputs := []Element{}
-	if o.Elasticsearch.Version == 6 {
-		addID := helpers.MakeID(id, "add_id")
+	if addID := helpers.MakeID(id, "add_id"); len(es.IDFields) > 0 || es.Version == 6 {
 		outputs = append(outputs, Remap{
 			ComponentID: addID,
 			Inputs:      helpers.MakeInputs(inputs...),
-			VRL: `._id = encode_base64(uuid_v4())
-if exists(.kubernetes.event.metadata.uid) {
-  ._id = .kubernetes.event.metadata.uid
-}`,
+			VRL:         documentIDVRL(es.IDFields),
 		})
 		inputs = []string{addID}
 	}
+
+	var componentID string
+	if es.DataStream != nil {
+		componentID = helpers.MakeID(id, "data_stream")
+		outputs = append(outputs, DataStreamRemap(componentID, inputs, *es.DataStream))
+	} else {
+		componentID = helpers.MakeID(id, "index")
+		outputs = append(outputs, commontemplate.TemplateRemap(componentID, inputs, es.Index, componentID, "Elasticsearch Index"))
+	}
 	sink := Output(id, o, []string{componentID}, componentID, secrets, op)
 	if strategy != nil {
 		strategy.VisitSink(sink)
 	}
 
+	request := common.NewRequest(id, strategy)
+	var httpAuth *obs.HTTPAuthentication
+	// requests authenticated by AWS SigV4 are signed by the sink
+	if es.Authentication != nil && es.Authentication.AWS == nil {
+		if es.Authentication.APIKey != nil {
+			request.SetHeaders(map[string]string{
+				"Authorization": "ApiKey " + helpers.SecretFrom(es.Authentication.APIKey),
+			})
+		} else {
+			httpAuth = &es.Authentication.HTTPAuthentication
+		}
+	}
+
 	outputs = append(outputs,
-		commontemplate.TemplateRemap(componentID, inputs, o.Elasticsearch.Index, componentID, "Elasticsearch Index"),
 		sink,
 		common.NewEncoding(id, ""),
 		common.NewAcknowledgments(id, strategy),
 		common.NewBatch(id, strategy),
 		common.NewBuffer(id, strategy),
-		common.NewRequest(id, strategy),
-		tls.New(id, o.TLS, secrets, op, Option{Name: URL, Value: o.Elasticsearch.URL}),
-		auth.HTTPAuth(id, o.Elasticsearch.Authentication, secrets, op),
+		request,
+		tls.New(id, o.TLS, secrets, op, Option{Name: URL, Value: es.URL}),
+		auth.HTTPAuth(id, httpAuth, secrets, op),
 	)
 
 	return outputs
 }
 
+// documentIDVRL generates the ID of the documents from a hash of the fields or a random one for Elasticsearch 6
+func documentIDVRL(fields []obs.FieldPath) string {
+	if len(fields) == 0 {
+		return `._id = encode_base64(uuid_v4())
+if exists(.kubernetes.event.metadata.uid) {
+  ._id = .kubernetes.event.metadata.uid
+}`
+	}
+	paths := make([]string, len(fields))
+	for i, f := range fields {
+		paths[i] = commontemplate.InternalFieldPath(string(f))
+	}
+	return fmt.Sprintf(`._id = sha2(encode_json([%s]), variant: "SHA-256")`, strings.Join(paths, ", "))
+}
+
+// DataStreamRemap evaluates the templates of the data stream name parts
+func DataStreamRemap(componentID string, inputs []string, ds obs.ElasticsearchDataStream) Element {
+	parts := []struct {
+		field, template, defaultValue string
+	}{
+		{"type", ds.Type, defaultDataStreamType},
+		{"dataset", ds.Dataset, defaultDataStreamDataset},
+		{"namespace", ds.Namespace, defaultDataStreamNamespace},
+	}
+	vrl := []string{}
+	for _, p := range parts {
+		template := p.template
+		if template == "" {
+			template = p.defaultValue
+		}
+		vrl = append(vrl, fmt.Sprintf("._internal.%s_%s = %s", componentID, p.field, commontemplate.TransformUserTemplateToVRL(template)))
+	}
+	return Remap{
+		Desc:        "Elasticsearch Data Stream",
+		ComponentID: componentID,
+		Inputs:      helpers.MakeInputs(inputs...),
+		VRL:         strings.Join(vrl, "\n"),
+	}
+}
+
 func Output(id string, o obs.OutputSpec, inputs []string, index string, secrets observability.Secrets, op Options) *Elasticsearch {
 	idKey := genhelper.NewOptionalPair("id_key", nil)
-	if o.Elasticsearch.Version == 6 {
+	if o.Elasticsearch.Version == 6 || len(o.Elasticsearch.IDFields) > 0 {
 		idKey.Value = "_id"
 	}
 	es := Elasticsearch{
-		ComponentID: id,
-		IDKey:       idKey,
-		Endpoint:    o.Elasticsearch.URL,
-		Inputs:      helpers.MakeInputs(inputs...),
-		Index:       index,
-		RootMixin:   common.NewRootMixin(nil),
-		Version:     o.Elasticsearch.Version,
+		ComponentID:           id,
+		IDKey:                 idKey,
+		Endpoint:              o.Elasticsearch.URL,
+		Inputs:                helpers.MakeInputs(inputs...),
+		Pipeline:              genhelper.NewOptionalPair("pipeline", nil),
+		SecurityConfig:        Nil,
+		AWSRegion:             genhelper.NewOptionalPair("aws.region", nil),
+		OpensearchServiceType: genhelper.NewOptionalPair("opensearch_service_type", nil),
+		RootMixin:             common.NewRootMixin(nil),
+		Version:               o.Elasticsearch.Version,
+	}
+	if o.Elasticsearch.DataStream != nil {
+		es.DataStream = index
+	} else {
+		es.Index = index
+	}
+	if o.Elasticsearch.Pipeline != "" {
+		es.Pipeline.Value = o.Elasticsearch.Pipeline
+	}
+	if a := o.Elasticsearch.Authentication; a != nil && a.AWS != nil {
+		es.SecurityConfig = cloudwatch.SigV4AuthConfig(a.AWS, secrets)
+		es.AWSRegion.Value = a.AWS.Region
+		if a.AWS.ServiceName == opensearchServerlessServiceName {
+			es.OpensearchServiceType.Value = "serverless"
+		}
 	}
 	return &es
 }
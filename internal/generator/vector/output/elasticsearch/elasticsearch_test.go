@@ -52,14 +52,16 @@ var _ = Describe("Generate Vector config", func() {
 						URL: "https://es.svc.infra.cluster:9200",
 					},
 					Index: `{.log_type||"none"}`,
-					Authentication: &obs.HTTPAuthentication{
-						Username: &obs.SecretReference{
-							Key:        constants.ClientUsername,
-							SecretName: secretName,
-						},
-						Password: &obs.SecretReference{
-							Key:        constants.ClientPassword,
-							SecretName: secretName,
+					Authentication: &obs.ElasticsearchAuthentication{
+						HTTPAuthentication: obs.HTTPAuthentication{
+							Username: &obs.SecretReference{
+								Key:        constants.ClientUsername,
+								SecretName: secretName,
+							},
+							Password: &obs.SecretReference{
+								Key:        constants.ClientPassword,
+								SecretName: secretName,
+							},
 						},
 					},
 					Version: 8,
@@ -118,6 +120,61 @@ var _ = Describe("Generate Vector config", func() {
 			spec.Elasticsearch.Authentication = nil
 			spec.Elasticsearch.Index = `foo-{.kubernetes.namespace||"none"}`
 		}, false, framework.NoOptions, "es_with_custom_index.toml"),
+		Entry("with data stream", func(spec *obs.OutputSpec) {
+			spec.Elasticsearch.Authentication = nil
+			spec.Elasticsearch.Index = ""
+			spec.Elasticsearch.DataStream = &obs.ElasticsearchDataStream{
+				Dataset:   `{.kubernetes.namespace_name||"none"}`,
+				Namespace: `{.log_type||"none"}`,
+			}
+		}, false, framework.NoOptions, "es_with_data_stream.toml"),
+		Entry("with ingest pipeline", func(spec *obs.OutputSpec) {
+			spec.Elasticsearch.Authentication = nil
+			spec.Elasticsearch.Pipeline = "my-pipeline"
+		}, false, framework.NoOptions, "es_with_pipeline.toml"),
+		Entry("with API key", func(spec *obs.OutputSpec) {
+			spec.Elasticsearch.Authentication = &obs.ElasticsearchAuthentication{
+				APIKey: &obs.SecretReference{
+					Key:        "api_key",
+					SecretName: secretName,
+				},
+			}
+		}, false, framework.NoOptions, "es_with_api_key.toml"),
+		Entry("with AWS SigV4 auth using access keys", func(spec *obs.OutputSpec) {
+			spec.Elasticsearch.URL = "https://search-logs.us-east-1.es.amazonaws.com"
+			spec.Elasticsearch.Authentication = &obs.ElasticsearchAuthentication{
+				AWS: &obs.AWSAuthentication{
+					CloudwatchAuthentication: obs.CloudwatchAuthentication{
+						Type: obs.CloudwatchAuthTypeAccessKey,
+						AWSAccessKey: &obs.CloudwatchAWSAccessKey{
+							KeyId:     obs.SecretReference{Key: constants.AWSAccessKeyID, SecretName: secretName},
+							KeySecret: obs.SecretReference{Key: constants.AWSSecretAccessKey, SecretName: secretName},
+						},
+					},
+					Region: "us-east-1",
+				},
+			}
+		}, false, framework.NoOptions, "es_with_aws_access_key.toml"),
+		Entry("with AWS SigV4 auth using an IAM role for OpenSearch Serverless", func(spec *obs.OutputSpec) {
+			spec.Elasticsearch.URL = "https://abcdef.us-east-1.aoss.amazonaws.com"
+			spec.Elasticsearch.Authentication = &obs.ElasticsearchAuthentication{
+				AWS: &obs.AWSAuthentication{
+					CloudwatchAuthentication: obs.CloudwatchAuthentication{
+						Type: obs.CloudwatchAuthTypeIAMRole,
+						IAMRole: &obs.CloudwatchIAMRole{
+							RoleARN: obs.SecretReference{Key: constants.AWSCredentialsKey, SecretName: secretName},
+							Token:   obs.BearerToken{From: obs.BearerTokenFromServiceAccount},
+						},
+					},
+					Region:      "us-east-1",
+					ServiceName: "aoss",
+				},
+			}
+		}, false, framework.NoOptions, "es_with_aws_iam_role.toml"),
+		Entry("with document ID fields", func(spec *obs.OutputSpec) {
+			spec.Elasticsearch.Authentication = nil
+			spec.Elasticsearch.IDFields = []obs.FieldPath{".kubernetes.pod_uid", ".timestamp", `.kubernetes.labels."app.kubernetes.io/name"`}
+		}, false, framework.NoOptions, "es_with_id_fields.toml"),
 		Entry("with tune parameters", func(spec *obs.OutputSpec) {
 			spec.Elasticsearch.Authentication = nil
 			spec.Elasticsearch.Tuning = &obs.ElasticsearchTuningSpec{
@@ -6,6 +6,7 @@ import (
 	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/normalize"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/azurelogsingestion"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/azuremonitor"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/cloudwatch"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/otlp"
@@ -16,6 +17,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/http"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/kafka"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/loki"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/s3"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/splunk"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/syslog"
 	corev1 "k8s.io/api/core/v1"
@@ -29,8 +31,9 @@ func New(o obs.OutputSpec, inputs []string, secrets map[string]*corev1.Secret, s
 	if threshold, hasPolicy := internalobs.Threshold(o.Limit); hasPolicy && threshold > 0 {
 		// Vector Throttle component cannot have zero threshold
 		throttleID := helpers.MakeID(baseID, "throttle")
-		els = append(els, normalize.NewThrottle(throttleID, inputs, threshold, "")...)
-		inputs = []string{throttleID}
+		var throttle []Element
+		throttle, inputs = normalize.NewThrottle(throttleID, inputs, *o.Limit, "")
+		els = append(els, throttle...)
 
 	}
 
@@ -51,8 +54,12 @@ func New(o obs.OutputSpec, inputs []string, secrets map[string]*corev1.Secret, s
 		els = append(els, http.New(baseID, o, inputs, secrets, strategy, op)...)
 	case obs.OutputTypeSyslog:
 		els = append(els, syslog.New(baseID, o, inputs, secrets, strategy, op)...)
+	case obs.OutputTypeAzureLogsIngestion:
+		els = append(els, azurelogsingestion.New(baseID, o, inputs, secrets, strategy, op)...)
 	case obs.OutputTypeAzureMonitor:
 		els = append(els, azuremonitor.New(baseID, o, inputs, secrets, strategy, op)...)
+	case obs.OutputTypeS3:
+		els = append(els, s3.New(baseID, o, inputs, secrets, strategy, op)...)
 	case obs.OutputTypeOTLP:
 		els = append(els, otlp.New(baseID, o, inputs, secrets, strategy, op)...)
 	}
@@ -8,16 +8,18 @@ import (
 	. "github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/cloudwatch"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/auth"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/tls"
 )
 
 type Http struct {
-	ComponentID string
-	Inputs      string
-	URI         string
-	Method      string
+	ComponentID    string
+	Inputs         string
+	URI            string
+	Method         string
+	SecurityConfig Element
 	common.RootMixin
 }
 
@@ -33,6 +35,7 @@ inputs = {{.Inputs}}
 uri = "{{.URI}}"
 method = "{{.Method}}"
 {{.Compression}}
+{{compose_one .SecurityConfig}}
 {{end}}
 `
 }
@@ -63,21 +66,45 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets observability.Sec
 			common.NewBuffer(id, strategy),
 			Request(id, o, strategy),
 			tls.New(id, o.TLS, secrets, op),
-			auth.HTTPAuth(id, o.HTTP.Authentication, secrets, op),
+			auth.HTTPAuth(id, httpAuthentication(o.HTTP), secrets, op),
 		},
 	)
 }
 
 func Output(id string, o obs.OutputSpec, inputs []string, secrets observability.Secrets, op Options) *Http {
 	return &Http{
-		ComponentID: id,
-		Inputs:      vectorhelpers.MakeInputs(inputs...),
-		URI:         o.HTTP.URL,
-		Method:      Method(o.HTTP),
-		RootMixin:   common.NewRootMixin(nil),
+		ComponentID:    id,
+		Inputs:         vectorhelpers.MakeInputs(inputs...),
+		URI:            o.HTTP.URL,
+		Method:         Method(o.HTTP),
+		SecurityConfig: awsAuthConfig(o.HTTP, secrets),
+		RootMixin:      common.NewRootMixin(nil),
 	}
 }
 
+// httpAuthentication returns the HTTP authentication of the output or nil when requests are signed by AWS SigV4
+func httpAuthentication(h *obs.HTTP) *obs.HTTPAuthentication {
+	if h.Authentication == nil || h.Authentication.AWS != nil {
+		return nil
+	}
+	return &h.Authentication.HTTPAuthentication
+}
+
+// awsAuthConfig generates the configuration for signing requests with AWS SigV4
+func awsAuthConfig(h *obs.HTTP, secrets observability.Secrets) Element {
+	if h.Authentication == nil || h.Authentication.AWS == nil {
+		return Nil
+	}
+	aws := h.Authentication.AWS
+	authConfig := cloudwatch.SigV4AuthConfig(aws, secrets)
+	authConfig.Region.Value = aws.Region
+	authConfig.Service.Value = aws.ServiceName
+	if aws.ServiceName == "" {
+		authConfig.Service.Value = cloudwatch.DefaultSigV4ServiceName
+	}
+	return authConfig
+}
+
 func Method(h *obs.HTTP) string {
 	if h == nil {
 		return "post"
@@ -68,14 +68,16 @@ var _ = Describe("Generate vector config", func() {
 							"h1": "v1",
 						},
 						Method: "POST",
-						Authentication: &obs.HTTPAuthentication{
-							Username: &obs.SecretReference{
-								Key:        constants.ClientUsername,
-								SecretName: secretName,
-							},
-							Password: &obs.SecretReference{
-								Key:        constants.ClientPassword,
-								SecretName: secretName,
+						Authentication: &obs.HTTPOutputAuthentication{
+							HTTPAuthentication: obs.HTTPAuthentication{
+								Username: &obs.SecretReference{
+									Key:        constants.ClientUsername,
+									SecretName: secretName,
+								},
+								Password: &obs.SecretReference{
+									Key:        constants.ClientPassword,
+									SecretName: secretName,
+								},
 							},
 						},
 					},
@@ -139,6 +141,35 @@ var _ = Describe("Generate vector config", func() {
 					},
 				}
 			}, secrets, false, framework.NoOptions, "http_with_tls_using_configmaps.toml"),
+			Entry("with AWS SigV4 auth using access keys", func(spec *obs.OutputSpec) {
+				spec.HTTP.Authentication = &obs.HTTPOutputAuthentication{
+					AWS: &obs.AWSAuthentication{
+						CloudwatchAuthentication: obs.CloudwatchAuthentication{
+							Type: obs.CloudwatchAuthTypeAccessKey,
+							AWSAccessKey: &obs.CloudwatchAWSAccessKey{
+								KeyId:     obs.SecretReference{Key: constants.AWSAccessKeyID, SecretName: secretName},
+								KeySecret: obs.SecretReference{Key: constants.AWSSecretAccessKey, SecretName: secretName},
+							},
+						},
+						Region:      "us-east-1",
+						ServiceName: "aoss",
+					},
+				}
+			}, secrets, false, framework.NoOptions, "http_with_auth_aws_access_key.toml"),
+			Entry("with AWS SigV4 auth using an IAM role", func(spec *obs.OutputSpec) {
+				spec.HTTP.Authentication = &obs.HTTPOutputAuthentication{
+					AWS: &obs.AWSAuthentication{
+						CloudwatchAuthentication: obs.CloudwatchAuthentication{
+							Type: obs.CloudwatchAuthTypeIAMRole,
+							IAMRole: &obs.CloudwatchIAMRole{
+								RoleARN: obs.SecretReference{Key: constants.AWSCredentialsKey, SecretName: secretName},
+								Token:   obs.BearerToken{From: obs.BearerTokenFromServiceAccount},
+							},
+						},
+						Region: "us-east-1",
+					},
+				}
+			}, secrets, false, framework.NoOptions, "http_with_auth_aws_iam_role.toml"),
 			Entry("with tuning", func(spec *obs.OutputSpec) {
 				spec.HTTP.Tuning = &obs.HTTPTuningSpec{
 					BaseOutputTuningSpec: *baseTune,
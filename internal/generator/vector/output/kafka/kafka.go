@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/openshift/cluster-logging-operator/internal/api/observability"
 	"net/url"
+	"sort"
 	"strings"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
@@ -28,6 +29,8 @@ type Kafka struct {
 	Inputs           string
 	BootstrapServers string
 	Topic            string
+	KeyField         genhelper.OptionalPair
+	HeadersKey       genhelper.OptionalPair
 	common.RootMixin
 }
 
@@ -42,6 +45,8 @@ type = "kafka"
 inputs = {{.Inputs}}
 bootstrap_servers = {{.BootstrapServers}}
 topic = "{{"{{"}} _internal.{{.Topic}} {{"}}"}}"
+{{.KeyField}}
+{{.HeadersKey}}
 healthcheck.enabled = false
 {{.Compression}}
 {{end}}
@@ -59,24 +64,28 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets observability.Sec
 		}
 	}
 	componentID := vectorhelpers.MakeID(id, "topic")
+	elements := []Element{
+		commontemplate.TemplateRemap(componentID, inputs, Topics(o), componentID, "Kafka Topic"),
+	}
+	sinkInputs := []string{componentID}
+	if o.Kafka.KeyField != "" || len(o.Kafka.Headers) > 0 {
+		metadataID := vectorhelpers.MakeID(id, "metadata")
+		elements = append(elements, MessageMetadataRemap(metadataID, sinkInputs, id, o.Kafka))
+		sinkInputs = []string{metadataID}
+	}
 	brokers := Brokers(o)
-	sink := sink(id, o, []string{componentID}, componentID, op, brokers)
+	sink := sink(id, o, sinkInputs, componentID, op, brokers)
 	if strategy != nil {
 		strategy.VisitSink(sink)
 	}
-	tlsConfig := []Element{Nil}
+	tlsConfig := Element(Nil)
+	skipVerify := false
 	if o.TLS != nil && isTlsBrokers(o) {
-		skipVerify := o.TLS.InsecureSkipVerify
+		skipVerify = o.TLS.InsecureSkipVerify
 		o.TLS.InsecureSkipVerify = false
-		tlsConfig = []Element{tls.New(id, o.TLS, secrets, op, Option{Name: tls.IncludeEnabled, Value: ""})}
-		if skipVerify {
-			tlsConfig = append(tlsConfig, InsecureTLS{
-				ComponentID: id,
-			})
-		}
+		tlsConfig = tls.New(id, o.TLS, secrets, op, Option{Name: tls.IncludeEnabled, Value: ""})
 	}
-	elements := []Element{
-		commontemplate.TemplateRemap(componentID, inputs, Topics(o), componentID, "Kafka Topic"),
+	elements = append(elements,
 		sink,
 		common.NewEncoding(id, common.CodecJSON, func(e *common.Encoding) {
 			e.TimeStampFormat.Value = common.TimeStampFormatRFC3339
@@ -85,9 +94,8 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets observability.Sec
 		common.NewBatch(id, strategy),
 		common.NewBuffer(id, strategy),
 		SASLConf(id, o.Kafka.Authentication, secrets),
-	}
-	elements = append(elements,
-		tlsConfig...,
+		tlsConfig,
+		LibrdkafkaConf(id, o, skipVerify),
 	)
 	return elements
 }
@@ -106,13 +114,55 @@ func isTlsBrokers(o obs.OutputSpec) bool {
 }
 
 func sink(id string, o obs.OutputSpec, inputs []string, topic string, op Options, brokers string) *Kafka {
-	return &Kafka{
+	k := &Kafka{
 		ComponentID:      id,
 		Inputs:           vectorhelpers.MakeInputs(inputs...),
 		Topic:            topic,
 		BootstrapServers: fmt.Sprintf("%q", brokers),
+		KeyField:         genhelper.NewOptionalPair("key_field", nil),
+		HeadersKey:       genhelper.NewOptionalPair("headers_key", nil),
 		RootMixin:        common.NewRootMixin(nil),
 	}
+	if o.Kafka.KeyField != "" {
+		k.KeyField.Value = "_internal." + keyField(id)
+	}
+	if len(o.Kafka.Headers) > 0 {
+		k.HeadersKey.Value = "_internal." + headersField(id)
+	}
+	return k
+}
+
+func keyField(id string) string {
+	return id + "_key"
+}
+
+func headersField(id string) string {
+	return id + "_headers"
+}
+
+// MessageMetadataRemap evaluates the templates of the message key and headers
+func MessageMetadataRemap(componentID string, inputs []string, id string, k *obs.Kafka) Element {
+	vrl := []string{}
+	if k.KeyField != "" {
+		vrl = append(vrl, fmt.Sprintf("._internal.%s = %s", keyField(id), commontemplate.TransformUserTemplateToVRL(k.KeyField)))
+	}
+	if len(k.Headers) > 0 {
+		names := make([]string, 0, len(k.Headers))
+		for name := range k.Headers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		vrl = append(vrl, fmt.Sprintf("._internal.%s = {}", headersField(id)))
+		for _, name := range names {
+			vrl = append(vrl, fmt.Sprintf("._internal.%s.%q = %s", headersField(id), name, commontemplate.TransformUserTemplateToVRL(k.Headers[name])))
+		}
+	}
+	return Remap{
+		Desc:        "Kafka Message Key and Headers",
+		ComponentID: componentID,
+		Inputs:      vectorhelpers.MakeInputs(inputs...),
+		VRL:         strings.Join(vrl, "\n"),
+	}
 }
 
 // Brokers returns the list of broker endpoints of a Kafka cluster.
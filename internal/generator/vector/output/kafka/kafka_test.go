@@ -120,6 +120,43 @@ var _ = Describe("Generate vector config", func() {
 			spec.Kafka.Topic = ""
 			spec.Kafka.Brokers = []obs.URL{`tcp://broker1:9092`, `tcp://broker2:9092`, `tcp://broker3:9092`}
 		}),
+		Entry("with message key and headers", "kafka_key_headers.toml", framework.NoOptions, false, func(spec *obs.OutputSpec) {
+			spec.Kafka.Topic = ""
+			spec.Kafka.KeyField = `{.kubernetes.namespace_name||"none"}-{.kubernetes.pod_name||"none"}`
+			spec.Kafka.Headers = map[string]string{
+				"log_type":   `{.log_type||"none"}`,
+				"cluster_id": `{.openshift.cluster_id||"none"}`,
+				"source":     "openshift",
+			}
+		}),
+		Entry("with librdkafka options and TLS.insecureSkipVerify=true", "kafka_librdkafka_options.toml", framework.NoOptions, false, func(spec *obs.OutputSpec) {
+			spec.Kafka.URL = "tls://broker1-kafka.svc.messaging.cluster.local:9092/mytopic"
+			spec.Kafka.Topic = ""
+			spec.Kafka.LibrdkafkaOptions = map[string]string{
+				"acks":               "all",
+				"client.id":          `clf "prod" \ east`,
+				"enable.idempotence": "true",
+			}
+			spec.TLS = &obs.OutputTLSSpec{
+				InsecureSkipVerify: true,
+				TLSSpec:            tlsSpec.TLSSpec,
+			}
+		}),
+		Entry("with OAUTHBEARER sasl", "kafka_sasl_oauthbearer.toml", framework.NoOptions, false, func(spec *obs.OutputSpec) {
+			spec.Kafka.URL = "tls://broker1-kafka.svc.messaging.cluster.local:9093/topic"
+			spec.Kafka.Topic = ""
+			spec.Kafka.Authentication = &obs.KafkaAuthentication{
+				SASL: &obs.SASLAuthentication{
+					Mechanism: obs.SASLMechanismOAuthBearer,
+					OAuthBearer: &obs.SASLOAuthBearer{
+						TokenURL:     "https://sso.example.com/oauth2/token",
+						ClientID:     obs.SecretReference{Key: "client_id", SecretName: secretName},
+						ClientSecret: obs.SecretReference{Key: "client_secret", SecretName: secretName},
+						Scope:        "kafka",
+					},
+				},
+			}
+		}),
 		Entry("with tuning", "kafka_tuning.toml", framework.NoOptions, true, func(spec *obs.OutputSpec) {
 			spec.Kafka.URL = "tcp://broker1-kafka.svc.messaging.cluster.local:9092/topic"
 			spec.Kafka.Topic = ""
@@ -1,16 +1,59 @@
 package kafka
 
-type InsecureTLS struct {
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
+)
+
+const (
+	optionSSLCertificateVerification = "enable.ssl.certificate.verification"
+)
+
+// LibrdkafkaOptions are options passed as is to the librdkafka client of the sink. Names and values are quoted
+type LibrdkafkaOptions struct {
 	ComponentID string
+	Options     map[string]string
 }
 
-func (i InsecureTLS) Name() string {
-	return "kafkaInsecureTLSTemplate"
+func (l LibrdkafkaOptions) Name() string {
+	return "kafkaLibrdkafkaOptionsTemplate"
 }
 
-func (i InsecureTLS) Template() string {
-	return `{{define "` + i.Name() + `" -}}
+func (l LibrdkafkaOptions) Template() string {
+	return `{{define "` + l.Name() + `" -}}
 [sinks.{{.ComponentID}}.librdkafka_options]
-"enable.ssl.certificate.verification" = "false"
+{{- range $name, $value := .Options}}
+{{$name}} = {{$value}}
+{{- end}}
 {{- end}}`
 }
+
+// LibrdkafkaConf generates the librdkafka options of the sink from the options of the spec and the ones required
+// by the authentication and TLS settings. The latter take precedence
+func LibrdkafkaConf(id string, o obs.OutputSpec, insecureSkipVerify bool) Element {
+	options := map[string]string{}
+	for name, value := range o.Kafka.LibrdkafkaOptions {
+		options[name] = value
+	}
+	if o.Kafka.Authentication != nil {
+		for name, value := range oauthBearerOptions(o.Kafka.Authentication.SASL) {
+			options[name] = value
+		}
+	}
+	if insecureSkipVerify {
+		options[optionSSLCertificateVerification] = "false"
+	}
+	if len(options) == 0 {
+		return Nil
+	}
+	quoted := map[string]string{}
+	for name, value := range options {
+		quoted[fmt.Sprintf("%q", name)] = fmt.Sprintf("%q", value)
+	}
+	return LibrdkafkaOptions{
+		ComponentID: id,
+		Options:     quoted,
+	}
+}
@@ -26,8 +26,10 @@ func (t SASL) Template() string {
 	return `{{define "vectorKafkaSasl"}}
 [sinks.{{.ComponentID}}.sasl]
 enabled = true
+{{- if .Username}}
 username = "{{.Username}}"
 password = "{{.Password}}"
+{{- end}}
 mechanism = "{{.Mechanism}}"
 {{end}}`
 }
@@ -35,6 +37,13 @@ mechanism = "{{.Mechanism}}"
 func SASLConf(id string, spec *obs.KafkaAuthentication, secrets observability.Secrets) Element {
 	if spec != nil {
 		saslAuth := spec.SASL
+		if saslAuth != nil && saslAuth.Mechanism == obs.SASLMechanismOAuthBearer && saslAuth.OAuthBearer != nil {
+			// the token is fetched by librdkafka using the client credentials set in the librdkafka options
+			return SASL{
+				ComponentID: id,
+				Mechanism:   obs.SASLMechanismOAuthBearer,
+			}
+		}
 		if saslAuth != nil && saslAuth.Username != nil && saslAuth.Password != nil {
 			sasl := SASL{
 				ComponentID: id,
@@ -51,3 +60,21 @@ func SASLConf(id string, spec *obs.KafkaAuthentication, secrets observability.Se
 
 	return Nil
 }
+
+// oauthBearerOptions returns the librdkafka options to fetch an OAUTHBEARER token with the OIDC client credentials grant
+func oauthBearerOptions(saslAuth *obs.SASLAuthentication) map[string]string {
+	if saslAuth == nil || saslAuth.Mechanism != obs.SASLMechanismOAuthBearer || saslAuth.OAuthBearer == nil {
+		return nil
+	}
+	oauth := saslAuth.OAuthBearer
+	options := map[string]string{
+		"sasl.oauthbearer.method":             "oidc",
+		"sasl.oauthbearer.client.id":          vectorhelpers.SecretFrom(&oauth.ClientID),
+		"sasl.oauthbearer.client.secret":      vectorhelpers.SecretFrom(&oauth.ClientSecret),
+		"sasl.oauthbearer.token.endpoint.url": oauth.TokenURL,
+	}
+	if oauth.Scope != "" {
+		options["sasl.oauthbearer.scope"] = oauth.Scope
+	}
+	return options
+}
@@ -0,0 +1,109 @@
+package s3
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/api/observability"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	genhelper "github.com/openshift/cluster-logging-operator/internal/generator/helpers"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/cloudwatch"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
+	commontemplate "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/template"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/tls"
+)
+
+const (
+	// DefaultKeyPrefix groups the objects by the log type of the records
+	DefaultKeyPrefix = `{.log_type||"unknown"}`
+
+	defaultCompression = "gzip"
+)
+
+type S3 struct {
+	Desc           string
+	ComponentID    string
+	Inputs         string
+	Region         string
+	Bucket         string
+	KeyPrefix      string
+	StorageClass   genhelper.OptionalPair
+	EndpointConfig Element
+	SecurityConfig Element
+	common.RootMixin
+}
+
+func (s S3) Name() string {
+	return "s3Template"
+}
+
+func (s S3) Template() string {
+	return `{{define "` + s.Name() + `" -}}
+{{if .Desc -}}
+# {{.Desc}}
+{{end -}}
+[sinks.{{.ComponentID}}]
+type = "aws_s3"
+inputs = {{.Inputs}}
+region = "{{.Region}}"
+bucket = "{{.Bucket}}"
+key_prefix = "{{"{{"}} _internal.{{.KeyPrefix}} {{"}}"}}/"
+{{.Compression}}
+{{.StorageClass}}
+{{compose_one .SecurityConfig}}
+healthcheck.enabled = false
+{{compose_one .EndpointConfig}}
+{{- end}}
+`
+}
+
+func (s *S3) SetCompression(algo string) {
+	s.Compression.Value = algo
+}
+
+func New(id string, o obs.OutputSpec, inputs []string, secrets observability.Secrets, strategy common.ConfigStrategy, op Options) []Element {
+	if genhelper.IsDebugOutput(op) {
+		return []Element{
+			Debug(id, vectorhelpers.MakeInputs(inputs...)),
+		}
+	}
+	keyPrefixID := vectorhelpers.MakeID(id, "key_prefix")
+	keyPrefix := o.S3.KeyPrefix
+	if keyPrefix == "" {
+		keyPrefix = DefaultKeyPrefix
+	}
+	sink := &S3{
+		Desc:           "S3 Bucket",
+		ComponentID:    id,
+		Inputs:         vectorhelpers.MakeInputs(keyPrefixID),
+		Region:         o.S3.Region,
+		Bucket:         o.S3.Bucket,
+		KeyPrefix:      keyPrefixID,
+		StorageClass:   genhelper.NewOptionalPair("storage_class", nil),
+		SecurityConfig: cloudwatch.AuthConfig(o.S3.Authentication, secrets),
+		EndpointConfig: cloudwatch.Endpoint{URL: o.S3.URL},
+		RootMixin:      common.NewRootMixin(defaultCompression),
+	}
+	if o.S3.StorageClass != "" {
+		sink.StorageClass.Value = string(o.S3.StorageClass)
+	}
+	if strategy != nil {
+		strategy.VisitSink(sink)
+	}
+
+	batch := common.NewBatch(id, strategy)
+	if o.S3.Tuning != nil && o.S3.Tuning.BatchTimeout > 0 {
+		batch.TimeoutSec.Value = o.S3.Tuning.BatchTimeout
+	}
+
+	return []Element{
+		commontemplate.TemplateRemap(keyPrefixID, inputs, keyPrefix, keyPrefixID, "S3 Key Prefix"),
+		sink,
+		common.NewEncoding(id, common.CodecJSON),
+		common.NewAcknowledgments(id, strategy),
+		batch,
+		common.NewBuffer(id, strategy),
+		common.NewRequest(id, strategy),
+		tls.New(id, o.TLS, secrets, op),
+	}
+}
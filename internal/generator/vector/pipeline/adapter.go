@@ -1,7 +1,10 @@
 package pipeline
 
 import (
+	"fmt"
+
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/drop"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/openshift/viaq"
 	"os"
 	"strconv"
@@ -21,13 +24,17 @@ type Pipeline struct {
 	index      int
 	filterMap  map[string]filter.InternalFilterSpec
 	Filters    []*PipelineFilter
+	Route      *PipelineRoute
 	inputSpecs []obs.InputSpec
 }
 
 func (o *Pipeline) Elements() []framework.Element {
 	elements := []framework.Element{}
 	for _, pf := range o.Filters {
-		elements = append(elements, pf.Element())
+		elements = append(elements, pf.Elements()...)
+	}
+	if o.Route != nil {
+		elements = append(elements, o.Route.Element())
 	}
 	return elements
 }
@@ -67,8 +74,16 @@ func NewPipeline(index int, p obs.PipelineSpec, inputs map[string]helpers.InputC
 		}
 
 		last := pipeline.Filters[len(pipeline.FilterRefs)-1]
-		for _, name := range pipeline.OutputRefs {
-			outputs[name].AddInputFrom(last)
+		if len(pipeline.Routes) > 0 {
+			pipeline.Route = NewPipelineRoute(pipeline.Name(), pipeline.PipelineSpec)
+			pipeline.Route.AddInputFrom(last)
+			for _, name := range pipeline.OutputRefs {
+				outputs[name].AddInputFrom(pipeline.Route.InputsFor(name))
+			}
+		} else {
+			for _, name := range pipeline.OutputRefs {
+				outputs[name].AddInputFrom(last)
+			}
 		}
 	} else {
 		for _, outputRef := range pipeline.OutputRefs {
@@ -144,12 +159,17 @@ func (p *Pipeline) initFilter(index int, filterRef string) {
 	}
 }
 
+// whenRoute is the name of the route output of records that match the when condition of a filter
+const whenRoute = "match"
+
 // PipelineFilter is an adapter between CLF pipeline filter instance and config generation
 type PipelineFilter struct {
 	pipeline obs.PipelineSpec
 	ids      []string
 	Next     []helpers.InputComponent
 	vrl      string
+	// when is the condition of the records to which the filter is applied
+	when string
 	// Distinguish between a Remap or Filter element
 	isFilterElement bool
 
@@ -170,9 +190,18 @@ func (pf *PipelineFilter) AddInputFrom(n helpers.InputComponent) {
 
 func NewPipelineFilter(pipelineName, filterRef string, spec filter.InternalFilterSpec, pipeline obs.PipelineSpec) *PipelineFilter {
 	ids := []string{helpers.MakePipelineID(pipelineName, filterRef)}
+	when := ""
+	if len(spec.When) > 0 {
+		when = drop.MatchTests(spec.When)
+	}
 	if spec.SuppliesTransform {
+		if when != "" {
+			// records which do not match bypass the transform through the unmatched route
+			ids = append(ids, helpers.MakeRouteInputID(whenRouteID(ids[0]), unmatchedRoute))
+		}
 		return &PipelineFilter{
-			ids: ids,
+			ids:  ids,
+			when: when,
 			transformFactory: func(inputs ...string) framework.Element {
 				return spec.TranformFactory(ids[0], inputs...)
 			},
@@ -183,18 +212,32 @@ func NewPipelineFilter(pipelineName, filterRef string, spec filter.InternalFilte
 		log.Error(err, "bad filter", "filterRef", filterRef, "spec.type", spec.Type, "spec.Name", spec.Name)
 		return nil
 	} else {
+		isFilterElement := spec.Type == obs.FilterTypeDrop
+		if when != "" {
+			if isFilterElement {
+				// keep records which do not match or pass the drop tests
+				vrl = fmt.Sprintf("!(%s) || (%s)", when, vrl)
+			} else {
+				vrl = fmt.Sprintf("if %s {\n%s\n}", when, vrl)
+			}
+		}
 		return &PipelineFilter{
-			pipeline: pipeline,
-			ids:      ids,
-			vrl:      vrl,
-			isFilterElement: func() bool {
-				return spec.Type == obs.FilterTypeDrop
-			}(),
+			pipeline:        pipeline,
+			ids:             ids,
+			vrl:             vrl,
+			when:            when,
+			isFilterElement: isFilterElement,
 		}
 	}
 }
 
-func (o *PipelineFilter) Element() framework.Element {
+// whenRouteID is the ID of the route transform which forwards the records matching the when condition of a filter
+// to the transform supplied by the filter
+func whenRouteID(id string) string {
+	return id + "_when"
+}
+
+func (o *PipelineFilter) Elements() []framework.Element {
 	inputs := []string{}
 	for _, n := range o.Next {
 		if n != nil {
@@ -202,19 +245,37 @@ func (o *PipelineFilter) Element() framework.Element {
 		}
 	}
 	if o.transformFactory != nil {
-		return o.transformFactory(inputs...)
+		if o.when == "" {
+			return []framework.Element{o.transformFactory(inputs...)}
+		}
+		routeID := whenRouteID(o.ids[0])
+		return []framework.Element{
+			elements.Route{
+				Desc:        "Route logs matching the filter condition",
+				ComponentID: routeID,
+				Inputs:      helpers.MakeInputs(inputs...),
+				Routes: map[string]string{
+					whenRoute: fmt.Sprintf("'''%s'''", o.when),
+				},
+			},
+			o.transformFactory(helpers.MakeRouteInputID(routeID, whenRoute)),
+		}
 	}
 
 	if o.isFilterElement {
-		return elements.Filter{
-			ComponentID: o.ids[0],
-			Inputs:      helpers.MakeInputs(inputs...),
-			Condition:   o.vrl,
+		return []framework.Element{
+			elements.Filter{
+				ComponentID: o.ids[0],
+				Inputs:      helpers.MakeInputs(inputs...),
+				Condition:   o.vrl,
+			},
 		}
 	}
-	return elements.Remap{
-		ComponentID: o.ids[0],
-		Inputs:      helpers.MakeInputs(inputs...),
-		VRL:         o.vrl,
+	return []framework.Element{
+		elements.Remap{
+			ComponentID: o.ids[0],
+			Inputs:      helpers.MakeInputs(inputs...),
+			VRL:         o.vrl,
+		},
 	}
 }
@@ -3,6 +3,7 @@ package pipeline_test
 import (
 	"fmt"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
@@ -205,5 +206,215 @@ var _ = Describe("pipeline/adapter.go", func() {
 			Expect(adapter.Filters).To(HaveLen(4), "expected journal, viaq, drop and dedot filters to be added to the pipeline")
 			Expect(mustLoad("adapter_test_drop_filter.toml")).To(EqualConfigFrom(adapter.Elements()))
 		})
+
+		DescribeTable("when the pipeline includes an infrastructure input with node sources", func(other obs.InputSpec) {
+			inputSpecs := []obs.InputSpec{
+				{Name: "infra-in", Type: obs.InputTypeInfrastructure, Infrastructure: &obs.Infrastructure{
+					Sources: []obs.InfrastructureSource{obs.InfrastructureSourceNode},
+				}},
+				other,
+			}
+			adapter := NewPipeline(0, obs.PipelineSpec{
+				Name:      "mypipeline",
+				InputRefs: []string{inputSpecs[0].Name, inputSpecs[1].Name},
+			}, map[string]helpers.InputComponent{
+				inputSpecs[0].Name: input.NewInput(inputSpecs[0], secrets, "", factory.ForwarderResourceNames{CommonName: constants.CollectorName}, nil),
+				inputSpecs[1].Name: input.NewInput(inputSpecs[1], secrets, "", factory.ForwarderResourceNames{CommonName: constants.CollectorName}, nil),
+			}, map[string]*output.Output{},
+				filter.NewInternalFilterMap(map[string]*obs.FilterSpec{}),
+				inputSpecs,
+			)
+			Expect(adapter.Filters).To(HaveLen(3), "expected journal, viaq and dedot filters to be added to the pipeline")
+			journal := adapter.Filters[0].Elements()
+			Expect(journal).To(HaveLen(1))
+			// only the debug records of the journal are dropped, the records of the other input pass the filter
+			Expect(journal[0]).To(HaveField("Condition", `!(.log_source == "node" && (.PRIORITY == "7" || .PRIORITY == 7))`))
+		},
+			Entry("should pass the records of a hostFiles input", obs.InputSpec{
+				Name: "hostfiles-in", Type: obs.InputTypeHostFiles, HostFiles: &obs.HostFiles{
+					Includes: []string{"/var/log/nvidia/*.log"},
+				},
+			}),
+			Entry("should pass the records of an HTTP receiver input", obs.InputSpec{
+				Name: "http-in", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{
+					Type: obs.ReceiverTypeHTTP,
+					Port: 8443,
+					HTTP: &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatNDJSON},
+				},
+			}),
+			Entry("should pass the records of an OTLP receiver input", obs.InputSpec{
+				Name: "otlp-in", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{
+					Type: obs.ReceiverTypeOTLP,
+					Port: 4318,
+					OTLP: &obs.OTLPReceiver{},
+				},
+			}),
+			Entry("should pass the records of a forward receiver input", obs.InputSpec{
+				Name: "forward-in", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{
+					Type:    obs.ReceiverTypeForward,
+					Port:    24224,
+					Forward: &obs.ForwardReceiver{},
+				},
+			}),
+			Entry("should pass the records of a kubernetes events input", obs.InputSpec{
+				Name: "events-in", Type: obs.InputTypeKubernetesEvents, Events: &obs.KubernetesEvents{},
+			}),
+		)
+
+		It("should add a route transform when routes are spec'd for the pipeline", func() {
+			inputSpecs := []obs.InputSpec{
+				{Name: "app-in", Type: obs.InputTypeApplication, Application: &obs.Application{}},
+			}
+			outputs := map[string]*output.Output{
+				"es-errors":  output.NewOutput(obs.OutputSpec{Name: "es-errors"}, secrets, nil),
+				"es-default": output.NewOutput(obs.OutputSpec{Name: "es-default"}, secrets, nil),
+				"es-all":     output.NewOutput(obs.OutputSpec{Name: "es-all"}, secrets, nil),
+			}
+			adapter := NewPipeline(0, obs.PipelineSpec{
+				Name:       "mypipeline",
+				InputRefs:  []string{inputSpecs[0].Name},
+				OutputRefs: []string{"es-errors", "es-default", "es-all"},
+				Routes: []obs.RouteSpec{
+					{
+						Name: "errors",
+						Tests: []obs.DropTest{
+							{
+								DropConditions: []obs.DropCondition{
+									{Field: ".level", Matches: "error|critical"},
+								},
+							},
+						},
+						OutputRefs: []string{"es-errors", "es-all"},
+					},
+					{
+						Name: "my-team",
+						Tests: []obs.DropTest{
+							{
+								DropConditions: []obs.DropCondition{
+									{Field: `.kubernetes.namespace_labels."team"`, Matches: "my-team"},
+									{Field: ".message", NotMatches: "healthz"},
+								},
+							},
+						},
+						OutputRefs: []string{"es-all"},
+					},
+				},
+				DefaultOutputRefs: []string{"es-default", "es-all"},
+			}, map[string]helpers.InputComponent{
+				inputSpecs[0].Name: input.NewInput(inputSpecs[0], secrets, "", factory.ForwarderResourceNames{CommonName: constants.CollectorName}, nil),
+			}, outputs,
+				filter.NewInternalFilterMap(map[string]*obs.FilterSpec{}),
+				inputSpecs,
+			)
+			Expect(adapter.Route).ToNot(BeNil(), "expected a route transform to be added to the pipeline")
+			Expect(`
+# Route logs to pipeline outputs by content
+[transforms.pipeline_mypipeline_route]
+type = "route"
+inputs = ["pipeline_mypipeline_viaqdedot_1"]
+route.errors = '''(match(to_string(.level) ?? "", r'error|critical'))'''
+route.my_team = '''(match(to_string(.kubernetes.namespace_labels."team") ?? "", r'my-team') && !match(to_string(.message) ?? "", r'healthz'))'''
+`).To(EqualConfigFrom(adapter.Route.Element()))
+			Expect(outputs["es-errors"].Inputs()).To(Equal([]string{"pipeline_mypipeline_route.errors"}))
+			Expect(outputs["es-default"].Inputs()).To(Equal([]string{"pipeline_mypipeline_route._unmatched"}))
+			Expect(outputs["es-all"].Inputs()).To(Equal([]string{"pipeline_mypipeline_route.errors", "pipeline_mypipeline_route.my_team", "pipeline_mypipeline_route._unmatched"}))
+		})
+
+		Describe("when filters define a when condition", func() {
+			var (
+				inputSpecs = []obs.InputSpec{
+					{Name: "app-in", Type: obs.InputTypeApplication, Application: &obs.Application{}},
+				}
+				when = []obs.DropTest{
+					{
+						DropConditions: []obs.DropCondition{
+							{Field: ".kubernetes.namespace_name", Matches: "^my-app$"},
+						},
+					},
+				}
+				adapter *Pipeline
+			)
+			BeforeEach(func() {
+				adapter = NewPipeline(0, obs.PipelineSpec{
+					Name:       "mypipeline",
+					InputRefs:  []string{inputSpecs[0].Name},
+					FilterRefs: []string{"my-labels", "my-drop", "my-sample"},
+				}, map[string]helpers.InputComponent{
+					inputSpecs[0].Name: input.NewInput(inputSpecs[0], secrets, "", factory.ForwarderResourceNames{CommonName: constants.CollectorName}, nil),
+				}, map[string]*output.Output{},
+					filter.NewInternalFilterMap(map[string]*obs.FilterSpec{
+						"my-labels": {
+							Name:            "my-labels",
+							Type:            obs.FilterTypeOpenshiftLabels,
+							When:            when,
+							OpenshiftLabels: map[string]string{"team": "my-team"},
+						},
+						"my-drop": {
+							Name: "my-drop",
+							Type: obs.FilterTypeDrop,
+							When: when,
+							DropTestsSpec: []obs.DropTest{
+								{
+									DropConditions: []obs.DropCondition{
+										{Field: ".level", Matches: "debug"},
+									},
+								},
+							},
+						},
+						"my-sample": {
+							Name:             "my-sample",
+							Type:             obs.FilterTypeSample,
+							When:             when,
+							SampleFilterSpec: &obs.SampleFilterSpec{Rate: 10},
+						},
+					}),
+					inputSpecs,
+				)
+				Expect(adapter.Filters).To(HaveLen(5), "expected viaq, labels, drop, sample and dedot filters to be added to the pipeline")
+			})
+
+			It("should apply the VRL of a remap filter only to matching records", func() {
+				Expect(`
+[transforms.pipeline_mypipeline_my_labels_1]
+type = "remap"
+inputs = ["pipeline_mypipeline_viaq_0"]
+source = '''
+if (match(to_string(.kubernetes.namespace_name) ?? "", r'^my-app$')) {
+  ._internal.openshift.labels = .openshift.labels = {"team":"my-team"}
+}
+'''
+`).To(EqualConfigFrom(adapter.Filters[1].Elements()))
+			})
+
+			It("should keep records which do not match the condition of a drop filter", func() {
+				Expect(`
+[transforms.pipeline_mypipeline_my_drop_2]
+type = "filter"
+inputs = ["pipeline_mypipeline_my_labels_1"]
+condition = '''
+!((match(to_string(.kubernetes.namespace_name) ?? "", r'^my-app$'))) || (!((match(to_string(.level) ?? "", r'debug'))))
+'''
+`).To(EqualConfigFrom(adapter.Filters[2].Elements()))
+			})
+
+			It("should route only matching records through the transform of a filter", func() {
+				Expect(`
+# Route logs matching the filter condition
+[transforms.pipeline_mypipeline_my_sample_3_when]
+type = "route"
+inputs = ["pipeline_mypipeline_my_drop_2"]
+route.match = '''(match(to_string(.kubernetes.namespace_name) ?? "", r'^my-app$'))'''
+
+[transforms.pipeline_mypipeline_my_sample_3]
+type = "sample"
+inputs = ["pipeline_mypipeline_my_sample_3_when.match"]
+rate = 10
+exclude = '''
+match(to_string(.level) ?? "", r'^(emergency|alert|critical|error)$')
+'''
+`).To(EqualConfigFrom(adapter.Filters[3].Elements()))
+				Expect(adapter.Filters[3].InputIDs()).To(Equal([]string{"pipeline_mypipeline_my_sample_3", "pipeline_mypipeline_my_sample_3_when._unmatched"}))
+			})
+		})
 	})
 })
@@ -0,0 +1,84 @@
+package pipeline
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/drop"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+// unmatchedRoute is the name of the route output of records that match no other route
+const unmatchedRoute = "_unmatched"
+
+// PipelineRoute is an adapter between the routes of a CLF pipeline and a route transform
+type PipelineRoute struct {
+	id             string
+	routes         []obs.RouteSpec
+	defaultOutputs []string
+	Next           []helpers.InputComponent
+}
+
+// routeInputs are the route outputs of a route transform that are the inputs of a single pipeline output
+type routeInputs []string
+
+func (r routeInputs) InputIDs() []string {
+	return r
+}
+
+func NewPipelineRoute(pipelineName string, spec obs.PipelineSpec) *PipelineRoute {
+	return &PipelineRoute{
+		id:             helpers.MakePipelineID(pipelineName, "route"),
+		routes:         spec.Routes,
+		defaultOutputs: spec.DefaultOutputRefs,
+	}
+}
+
+func (r *PipelineRoute) ID() string {
+	return r.id
+}
+
+func (r *PipelineRoute) AddInputFrom(n helpers.InputComponent) {
+	r.Next = append(r.Next, n)
+}
+
+// InputsFor returns the route outputs that forward records to the named pipeline output
+func (r *PipelineRoute) InputsFor(outputName string) helpers.InputComponent {
+	ids := routeInputs{}
+	for _, route := range r.routes {
+		for _, ref := range route.OutputRefs {
+			if ref == outputName {
+				ids = append(ids, helpers.MakeRouteInputID(r.id, helpers.FormatComponentID(route.Name)))
+				break
+			}
+		}
+	}
+	for _, ref := range r.defaultOutputs {
+		if ref == outputName {
+			ids = append(ids, helpers.MakeRouteInputID(r.id, unmatchedRoute))
+			break
+		}
+	}
+	return ids
+}
+
+func (r *PipelineRoute) Element() framework.Element {
+	inputs := []string{}
+	for _, n := range r.Next {
+		if n != nil {
+			inputs = append(inputs, n.InputIDs()...)
+		}
+	}
+	routes := map[string]string{}
+	for _, route := range r.routes {
+		routes[helpers.FormatComponentID(route.Name)] = fmt.Sprintf("'''%s'''", drop.MatchTests(route.Tests))
+	}
+	return elements.Route{
+		Desc:        "Route logs to pipeline outputs by content",
+		ComponentID: r.id,
+		Inputs:      helpers.MakeInputs(inputs...),
+		Routes:      routes,
+	}
+}
@@ -0,0 +1,37 @@
+package source
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+func NewForwardSource(id, inputName string, input obs.InputSpec) framework.Element {
+	return ForwardReceiver{
+		ID:            id,
+		InputName:     inputName,
+		ListenAddress: helpers.ListenOnAllLocalInterfacesAddress(),
+		ListenPort:    input.Receiver.Port,
+	}
+}
+
+type ForwardReceiver struct {
+	ID            string
+	InputName     string
+	ListenAddress string
+	ListenPort    int32
+}
+
+func (ForwardReceiver) Name() string {
+	return "forwardReceiver"
+}
+
+func (i ForwardReceiver) Template() string {
+	return `
+{{define "` + i.Name() + `" -}}
+[sources.{{.ID}}]
+type = "fluent"
+address = "{{.ListenAddress}}:{{.ListenPort}}"
+{{end}}
+`
+}
@@ -0,0 +1,75 @@
+package source
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	readFromBeginning = "beginning"
+	readFromEnd       = "end"
+
+	// multilineTimeoutMs is the time to wait for the next line of a multiline record before it is forwarded
+	multilineTimeoutMs = 1000
+)
+
+type HostFiles struct {
+	framework.ComponentID
+	Desc                  string
+	Includes              string
+	Excludes              string
+	ReadFrom              string
+	MultilineStartPattern string
+	MultilineTimeoutMs    int
+}
+
+func (HostFiles) Name() string {
+	return "hostFilesSource"
+}
+
+func (h HostFiles) Template() string {
+	return `
+{{define "` + h.Name() + `" -}}
+# {{.Desc}}
+[sources.{{.ComponentID}}]
+type = "file"
+include = {{.Includes}}
+{{- if .Excludes}}
+exclude = {{.Excludes}}
+{{- end}}
+read_from = "{{.ReadFrom}}"
+{{- if .MultilineStartPattern}}
+
+[sources.{{.ComponentID}}.multiline]
+mode = "halt_before"
+start_pattern = {{.MultilineStartPattern}}
+condition_pattern = {{.MultilineStartPattern}}
+timeout_ms = {{.MultilineTimeoutMs}}
+{{- end}}
+{{end}}`
+}
+
+// NewHostFiles returns a file source collecting the files of a hostFiles input where a multiline record is
+// started by each line matching the start pattern
+func NewHostFiles(id string, spec obs.HostFiles) HostFiles {
+	h := HostFiles{
+		ComponentID: id,
+		Desc:        "Logs from host files",
+		Includes:    helpers.MakeInputs(spec.Includes...),
+		ReadFrom:    readFromEnd,
+	}
+	if len(spec.Excludes) > 0 {
+		h.Excludes = helpers.MakeInputs(spec.Excludes...)
+	}
+	if spec.ReadFromBeginning {
+		h.ReadFrom = readFromBeginning
+	}
+	if spec.MultilineStartPattern != "" {
+		h.MultilineStartPattern = fmt.Sprintf("%q", spec.MultilineStartPattern)
+		h.MultilineTimeoutMs = multilineTimeoutMs
+	}
+	return h
+}
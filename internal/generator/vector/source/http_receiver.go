@@ -1,6 +1,8 @@
 package source
 
 import (
+	"fmt"
+
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
@@ -8,13 +10,41 @@ import (
 )
 
 func NewHttpSource(id, inputName string, input obs.InputSpec) (framework.Element, string) {
-	return HttpReceiver{
+	receiver := HttpReceiver{
 		ID:            id,
 		InputName:     inputName,
 		ListenAddress: helpers.ListenOnAllLocalInterfacesAddress(),
 		ListenPort:    input.Receiver.Port,
 		Format:        string(input.Receiver.HTTP.Format),
-	}, id
+		Framing:       httpReceiverFraming(input.Receiver.HTTP.Format),
+		Codec:         httpReceiverCodec(input.Receiver.HTTP.Format),
+	}
+	if auth := input.Receiver.Authentication; auth != nil {
+		if auth.Username != nil && auth.Password != nil {
+			receiver.Username = helpers.SecretFrom(auth.Username)
+			receiver.Password = helpers.SecretFrom(auth.Password)
+		}
+		if auth.Token != nil {
+			receiver.Token = helpers.SecretFrom(auth.Token)
+		}
+	}
+	return receiver, id
+}
+
+// httpReceiverFraming returns the framing method of a request body which holds more than one record per line
+func httpReceiverFraming(format obs.HTTPReceiverFormat) string {
+	switch format {
+	case obs.HTTPReceiverFormatNDJSON, obs.HTTPReceiverFormatText:
+		return "newline_delimited"
+	}
+	return ""
+}
+
+func httpReceiverCodec(format obs.HTTPReceiverFormat) string {
+	if format == obs.HTTPReceiverFormatText {
+		return "bytes"
+	}
+	return "json"
 }
 
 type HttpReceiver struct {
@@ -23,6 +53,11 @@ type HttpReceiver struct {
 	ListenAddress string
 	ListenPort    int32
 	Format        string
+	Framing       string
+	Codec         string
+	Username      string
+	Password      string
+	Token         string
 }
 
 func (HttpReceiver) Name() string {
@@ -35,24 +70,38 @@ func (i HttpReceiver) Template() string {
 [sources.{{.ID}}]
 type = "http_server"
 address = "{{.ListenAddress}}:{{.ListenPort}}"
-decoding.codec = "json"
+{{- if .Framing}}
+framing.method = "{{.Framing}}"
+{{- end}}
+decoding.codec = "{{.Codec}}"
+{{- if .Username}}
+auth.username = "{{.Username}}"
+auth.password = "{{.Password}}"
+{{- end}}
+{{- if .Token}}
+auth.strategy = "custom"
+auth.source = '.headers.authorization == "Bearer {{.Token}}"'
+{{- end}}
 {{end}}
 `
 }
 
-func NewSplitTransform(id, inputs string) (framework.Element, string) {
+// NewSplitTransform splits a record into a record per element of the array at path
+func NewSplitTransform(id, path string, inputs ...string) (framework.Element, string) {
 	splitID := helpers.MakeID(id, "split")
 	return elements.Remap{
 		ComponentID: splitID,
-		Inputs:      helpers.MakeInputs(inputs),
-		VRL:         `if exists(.items) && is_array(.items) {. = unnest!(.items)} else {.}`,
+		Inputs:      helpers.MakeInputs(inputs...),
+		VRL:         fmt.Sprintf(`if exists(%[1]s) && is_array(%[1]s) {. = unnest!(%[1]s)} else {.}`, path),
 	}, splitID
 }
-func NewItemsTransform(id, inputs string) (framework.Element, string) {
+
+// NewItemsTransform replaces a record with the split element at path
+func NewItemsTransform(id, path string, inputs ...string) (framework.Element, string) {
 	itemsID := helpers.MakeID(id, "items")
 	return elements.Remap{
 		ComponentID: itemsID,
-		Inputs:      helpers.MakeInputs(inputs),
-		VRL:         `if exists(.items) {. = .items} else {.}`,
+		Inputs:      helpers.MakeInputs(inputs...),
+		VRL:         fmt.Sprintf(`if exists(%[1]s) {. = %[1]s} else {.}`, path),
 	}, itemsID
 }
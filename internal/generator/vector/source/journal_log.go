@@ -1,23 +1,105 @@
 package source
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 )
 
-const JournalLogTemplate = `
-{{define "inputSourceJournalTemplate" -}}
+// journalPriorityField is the journal field of the syslog priority of an entry
+const journalPriorityField = "PRIORITY"
+
+type JournalLog struct {
+	framework.ComponentID
+	Desc            string
+	IncludeUnits    string
+	ExcludeUnits    string
+	IncludeMatches  []JournalMatch
+	CurrentBootOnly *bool
+}
+
+// JournalMatch is a journal field and the list of values of the entries to collect
+type JournalMatch struct {
+	Field  string
+	Values string
+}
+
+func (j JournalLog) Name() string {
+	return "inputSourceJournalTemplate"
+}
+
+func (j JournalLog) Template() string {
+	return `
+{{define "` + j.Name() + `" -}}
 [sources.{{.ComponentID}}]
 type = "journald"
 journal_directory = "/var/log/journal"
+{{- if .IncludeUnits}}
+include_units = {{.IncludeUnits}}
+{{- end}}
+{{- if .ExcludeUnits}}
+exclude_units = {{.ExcludeUnits}}
+{{- end}}
+{{- range .IncludeMatches}}
+include_matches.{{.Field}} = {{.Values}}
+{{- end}}
+{{- if .CurrentBootOnly}}
+current_boot_only = {{.CurrentBootOnly}}
+{{- end}}
 {{end}}`
+}
 
-type JournalLog = framework.ConfLiteral
+// NewJournalLog returns a journald source which collects the entries matching the journal filters, if any
+func NewJournalLog(id string, journal *obs.Journal) JournalLog {
+	j := JournalLog{
+		ComponentID: id,
+		Desc:        "Logs from linux journal",
+	}
+	if journal == nil {
+		return j
+	}
+	j.IncludeUnits = units(journal.IncludeUnits)
+	j.ExcludeUnits = units(journal.ExcludeUnits)
+	j.CurrentBootOnly = journal.CurrentBootOnly
+	matches := map[string][]string{}
+	for field, values := range journal.Matches {
+		matches[field] = values
+	}
+	if journal.MinimumPriority != "" {
+		var priorities []string
+		for i, p := range obs.JournalPriorities {
+			priorities = append(priorities, strconv.Itoa(i))
+			if p == journal.MinimumPriority {
+				break
+			}
+		}
+		matches[journalPriorityField] = priorities
+	}
+	fields := make([]string, 0, len(matches))
+	for field := range matches {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		j.IncludeMatches = append(j.IncludeMatches, JournalMatch{
+			Field:  fmt.Sprintf("%q", field),
+			Values: helpers.MakeInputs(matches[field]...),
+		})
+	}
+	return j
+}
 
-func NewJournalLog(id string) JournalLog {
-	return JournalLog{
-		ComponentID:  id,
-		Desc:         "Logs from linux journal",
-		TemplateName: "inputSourceJournalTemplate",
-		TemplateStr:  JournalLogTemplate,
+func units(list []obs.SystemdUnit) string {
+	if len(list) == 0 {
+		return ""
+	}
+	names := make([]string, len(list))
+	for i, u := range list {
+		names[i] = string(u)
 	}
+	return helpers.MakeInputs(names...)
 }
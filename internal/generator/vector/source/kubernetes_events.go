@@ -0,0 +1,89 @@
+package source
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	// KubernetesEventsEndpoint is the kubernetes API endpoint listing the events of all namespaces
+	KubernetesEventsEndpoint = "https://kubernetes.default.svc/api/v1/events"
+
+	// kubernetesNamespaceEventsEndpoint is the kubernetes API endpoint listing the events of a namespace
+	kubernetesNamespaceEventsEndpoint = "https://kubernetes.default.svc/api/v1/namespaces/%s/events"
+)
+
+// NewKubernetesEventsSources returns the sources which poll the kubernetes API for events, one for each of the
+// namespaces or a single one for all namespaces. The events are listed with a resourceVersion of "0" so they
+// are served from the API server cache instead of etcd. The events are narrowed by a field selector when a
+// filter matches a single value since a field selector can not match a list
+func NewKubernetesEventsSources(id, token, caFile string, interval int64, events obs.KubernetesEvents) ([]framework.Element, []string) {
+	var selectors []string
+	if len(events.InvolvedObjectKinds) == 1 {
+		selectors = append(selectors, "involvedObject.kind="+events.InvolvedObjectKinds[0])
+	}
+	if len(events.Types) == 1 {
+		selectors = append(selectors, "type="+string(events.Types[0]))
+	}
+	newSource := func(id, endpoint string) KubernetesEvents {
+		return KubernetesEvents{
+			ID:              id,
+			Endpoint:        endpoint,
+			FieldSelector:   strings.Join(selectors, ","),
+			IntervalSeconds: interval,
+			Token:           token,
+			CAFile:          caFile,
+		}
+	}
+	if len(events.Namespaces) == 0 {
+		return []framework.Element{newSource(id, KubernetesEventsEndpoint)}, []string{id}
+	}
+	var els []framework.Element
+	var ids []string
+	for _, ns := range events.Namespaces {
+		nsID := helpers.MakeID(id, ns)
+		els = append(els, newSource(nsID, fmt.Sprintf(kubernetesNamespaceEventsEndpoint, ns)))
+		ids = append(ids, nsID)
+	}
+	return els, ids
+}
+
+type KubernetesEvents struct {
+	ID              string
+	Endpoint        string
+	FieldSelector   string
+	IntervalSeconds int64
+	Token           string
+	CAFile          string
+}
+
+func (KubernetesEvents) Name() string {
+	return "kubernetesEventsSource"
+}
+
+func (e KubernetesEvents) Template() string {
+	return `
+{{define "` + e.Name() + `" -}}
+[sources.{{.ID}}]
+type = "http_client"
+endpoint = "{{.Endpoint}}"
+scrape_interval_secs = {{.IntervalSeconds}}
+decoding.codec = "json"
+query.resourceVersion = ["0"]
+{{- if .FieldSelector}}
+query.fieldSelector = ["{{.FieldSelector}}"]
+{{- end}}
+
+[sources.{{.ID}}.auth]
+strategy = "bearer"
+token = "{{.Token}}"
+
+[sources.{{.ID}}.tls]
+ca_file = {{.CAFile}}
+{{end}}
+`
+}
@@ -16,6 +16,8 @@ type KubernetesLogs struct {
 	IncludePaths       string
 	ExcludePaths       string
 	ExtraLabelSelector string
+	// ExtraNamespaceLabelSelector selects the pods of the namespaces with matching labels
+	ExtraNamespaceLabelSelector string
 }
 
 func (kl KubernetesLogs) Name() string {
@@ -39,6 +41,9 @@ exclude_paths_glob_patterns = {{.ExcludePaths}}
 {{- if gt (len .ExtraLabelSelector) 0 }}
 extra_label_selector = "{{.ExtraLabelSelector}}"
 {{- end}}
+{{- if gt (len .ExtraNamespaceLabelSelector) 0 }}
+extra_namespace_label_selector = "{{.ExtraNamespaceLabelSelector}}"
+{{- end}}
 pod_annotation_fields.pod_labels = "kubernetes.labels"
 pod_annotation_fields.pod_namespace = "kubernetes.namespace_name"
 pod_annotation_fields.pod_annotations = "kubernetes.annotations"
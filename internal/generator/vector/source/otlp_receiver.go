@@ -0,0 +1,54 @@
+package source
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+// otlpLoopbackAddress binds OTLP/gRPC to an ephemeral port of the loopback interface when it is not enabled
+// because the source requires both protocols
+const otlpLoopbackAddress = "127.0.0.1:0"
+
+// NewOTLPSource returns an OpenTelemetry source and the ID of its logs output
+func NewOTLPSource(id, inputName string, input obs.InputSpec) (framework.Element, string) {
+	listenAddress := helpers.ListenOnAllLocalInterfacesAddress()
+	receiver := OTLPReceiver{
+		ID:          id,
+		InputName:   inputName,
+		HTTPAddress: fmt.Sprintf("%s:%d", listenAddress, input.Receiver.Port),
+		GRPCAddress: otlpLoopbackAddress,
+	}
+	if input.Receiver.OTLP != nil && input.Receiver.OTLP.GRPCPort != nil {
+		receiver.GRPCAddress = fmt.Sprintf("%s:%d", listenAddress, *input.Receiver.OTLP.GRPCPort)
+	}
+	return receiver, helpers.MakeRouteInputID(id, "logs")
+}
+
+type OTLPReceiver struct {
+	ID          string
+	InputName   string
+	HTTPAddress string
+	GRPCAddress string
+}
+
+func (OTLPReceiver) Name() string {
+	return "otlpReceiver"
+}
+
+func (i OTLPReceiver) Template() string {
+	return `
+{{define "` + i.Name() + `" -}}
+[sources.{{.ID}}]
+type = "opentelemetry"
+
+[sources.{{.ID}}.http]
+address = "{{.HTTPAddress}}"
+
+[sources.{{.ID}}.grpc]
+address = "{{.GRPCAddress}}"
+{{end}}
+`
+}
@@ -6,13 +6,18 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 )
 
-func NewSyslogSource(id, inputName string, input obs.InputSpec) framework.Element {
-	return SyslogReceiver{
+func NewSyslogSource(id, inputName string, input obs.InputSpec, mode obs.SyslogReceiverProtocol) framework.Element {
+	receiver := SyslogReceiver{
 		ID:            id,
 		InputName:     inputName,
 		ListenAddress: helpers.ListenOnAllLocalInterfacesAddress(),
 		ListenPort:    input.Receiver.Port,
+		Mode:          mode,
 	}
+	if input.Receiver.Syslog != nil && input.Receiver.Syslog.MaxMessageLength != nil {
+		receiver.MaxLength = *input.Receiver.Syslog.MaxMessageLength
+	}
+	return receiver
 }
 
 type SyslogReceiver struct {
@@ -20,6 +25,8 @@ type SyslogReceiver struct {
 	InputName     string
 	ListenAddress string
 	ListenPort    int32
+	Mode          obs.SyslogReceiverProtocol
+	MaxLength     int64
 }
 
 func (SyslogReceiver) Name() string {
@@ -32,7 +39,10 @@ func (i SyslogReceiver) Template() string {
 [sources.{{.ID}}]
 type = "syslog"
 address = "{{.ListenAddress}}:{{.ListenPort}}"
-mode = "tcp"
+mode = "{{.Mode}}"
+{{- if .MaxLength}}
+max_length = {{.MaxLength}}
+{{- end}}
 {{end}}
 `
 }
@@ -6,6 +6,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	corev1 "k8s.io/api/core/v1"
@@ -73,4 +74,29 @@ var _ = Describe("Reconcile Service", func() {
 			To(Equal(certSecret))
 	})
 
+	It("should expose each port of an input service", func() {
+		inputServiceName := "test-input-service"
+		Expect(ReconcileInputService(
+			reqClient,
+			constants.OpenshiftNS,
+			inputServiceName,
+			componentName,
+			certSecret,
+			[]corev1.ServicePort{
+				{Name: "tcp", Port: port, Protocol: corev1.ProtocolTCP},
+				{Name: "udp", Port: port, Protocol: corev1.ProtocolUDP},
+			},
+			obs.ReceiverTypeSyslog,
+			owner,
+			commonLabels)).To(Succeed())
+
+		Expect(reqClient.Get(context.TODO(), types.NamespacedName{Name: inputServiceName, Namespace: namespace.Name}, serviceInstance)).Should(Succeed())
+		Expect(serviceInstance.Spec.Ports).To(HaveLen(2))
+		Expect(serviceInstance.Spec.Ports[0].Name).To(Equal("tcp"))
+		Expect(serviceInstance.Spec.Ports[0].Protocol).To(Equal(corev1.ProtocolTCP))
+		Expect(serviceInstance.Spec.Ports[1].Name).To(Equal("udp"))
+		Expect(serviceInstance.Spec.Ports[1].Protocol).To(Equal(corev1.ProtocolUDP))
+		Expect(serviceInstance.Spec.Ports[1].Port).To(Equal(port))
+	})
+
 })
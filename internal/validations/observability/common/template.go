@@ -0,0 +1,9 @@
+package common
+
+import (
+	"regexp"
+)
+
+// TemplateRegex matches the template syntax of the API fields which reference fields of the record
+// (e.g. `{.kubernetes.namespace_name||"none"}`). It is the kubebuilder validation pattern of those fields
+var TemplateRegex = regexp.MustCompile(`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`)
@@ -1,13 +1,80 @@
 package filters
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// saltRegex matches the characters allowed in the salt of a redact filter which is embedded in a VRL string
+var saltRegex = regexp.MustCompile(`^[A-Za-z0-9+/=._~-]+$`)
+
+// modifyValueRegex matches the characters allowed in a value of a modify filter which is read from a ConfigMap or
+// Secret and embedded in a VRL string: printable ASCII characters other than the double quote and the backslash
+var modifyValueRegex = regexp.MustCompile(`^[ !#-\[\]-~]*$`)
+
 func Validate(context internalcontext.ForwarderContext) {
 	filterMap := internalobs.FilterMap(context.Forwarder.Spec)
 	for _, filter := range filterMap {
-		internalobs.SetCondition(&context.Forwarder.Status.FilterConditions, ValidateFilter(*filter))
+		condition := ValidateFilter(*filter)
+		if condition.Status == metav1.ConditionTrue {
+			if messages := common.ValidateValueReference(internalobs.FilterValueReferences(*filter), context.Secrets, context.ConfigMaps); len(messages) > 0 {
+				condition.Status = metav1.ConditionFalse
+				condition.Reason = obs.ReasonValidationFailure
+				condition.Message = fmt.Sprintf("%s: %s", filter.Name, strings.Join(messages, ","))
+			} else if message := validateRedactSalt(*filter, context.Secrets); message != "" {
+				condition.Status = metav1.ConditionFalse
+				condition.Reason = obs.ReasonValidationFailure
+				condition.Message = fmt.Sprintf("%s: %s", filter.Name, message)
+			} else if message := validateModifyValues(*filter, context.Secrets, context.ConfigMaps); message != "" {
+				condition.Status = metav1.ConditionFalse
+				condition.Reason = obs.ReasonValidationFailure
+				condition.Message = fmt.Sprintf("%s: %s", filter.Name, message)
+			}
+		}
+		internalobs.SetCondition(&context.Forwarder.Status.FilterConditions, condition)
+	}
+}
+
+// validateRedactSalt validates the salt of the hash replacement of a redact filter only contains characters
+// which can be embedded in a VRL string
+func validateRedactSalt(filter obs.FilterSpec, secrets map[string]*corev1.Secret) string {
+	if filter.Type != obs.FilterTypeRedact || filter.RedactFilterSpec.Replacement == nil || filter.RedactFilterSpec.Replacement.Salt == nil {
+		return ""
+	}
+	salt := filter.RedactFilterSpec.Replacement.Salt
+	if secret, found := secrets[salt.SecretName]; found && !saltRegex.Match(secret.Data[salt.Key]) {
+		return "salt must only contain alphanumeric characters and +/=._~-"
+	}
+	return ""
+}
+
+// validateModifyValues validates the values of a modify filter which are read from a ConfigMap or Secret only contain
+// characters which can be embedded in a VRL string
+func validateModifyValues(filter obs.FilterSpec, secrets map[string]*corev1.Secret, configMaps map[string]*corev1.ConfigMap) string {
+	if filter.Type != obs.FilterTypeModify || filter.ModifyFilterSpec == nil {
+		return ""
+	}
+	for _, op := range filter.ModifyFilterSpec.Operations {
+		if op.ValueFrom == nil {
+			continue
+		}
+		var value []byte
+		if secret, found := secrets[op.ValueFrom.SecretName]; found && op.ValueFrom.SecretName != "" {
+			value = secret.Data[op.ValueFrom.Key]
+		} else if configMap, found := configMaps[op.ValueFrom.ConfigMapName]; found && op.ValueFrom.ConfigMapName != "" {
+			value = []byte(configMap.Data[op.ValueFrom.Key])
+		}
+		if !modifyValueRegex.Match(value) {
+			return fmt.Sprintf("value of %s must only contain printable ASCII characters other than '\"' and '\\'", op.Field)
+		}
 	}
+	return ""
 }
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/common"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/set"
 	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -19,11 +21,30 @@ var (
 func ValidateFilter(spec obs.FilterSpec) (condition metav1.Condition) {
 
 	var results []string
+	for i, test := range spec.When {
+		if testErrors := ValidateDropTest(test); len(testErrors) != 0 {
+			results = append(results, fmt.Sprintf("%s: when[%d] %v", spec.Name, i, testErrors))
+		}
+	}
 	switch spec.Type {
 	case obs.FilterTypeDrop:
 		results = append(results, validateDropFilter(spec)...)
 	case obs.FilterTypePrune:
 		results = append(results, validatePruneFilter(spec)...)
+	case obs.FilterTypeParse:
+		results = append(results, validateParseFilter(spec)...)
+	case obs.FilterTypeDetectMultiline:
+		results = append(results, validateDetectMultilineFilter(spec)...)
+	case obs.FilterTypeRemap:
+		results = append(results, validateRemapFilter(spec)...)
+	case obs.FilterTypeSample:
+		results = append(results, validateSampleFilter(spec)...)
+	case obs.FilterTypeDedupe:
+		results = append(results, validateDedupeFilter(spec)...)
+	case obs.FilterTypeRedact:
+		results = append(results, validateRedactFilter(spec)...)
+	case obs.FilterTypeModify:
+		results = append(results, validateModifyFilter(spec)...)
 	}
 	condition = internalobs.NewConditionFromPrefix(obs.ConditionTypeValidFilterPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("filter %q is valid", spec.Name))
 	if len(results) > 0 {
@@ -41,30 +62,9 @@ func validateDropFilter(filterSpec obs.FilterSpec) (results []string) {
 		results = append(results, fmt.Sprintf("%q drop filter must have at least one test spec'd", filterSpec.Name))
 	}
 
-	var err error
 	// Validate each test
 	for i, dropTest := range filterSpec.DropTestsSpec {
-		testErrors := []string{}
-		// For each test, validate conditions
-		for _, testCondition := range dropTest.DropConditions {
-			if err := validateFieldPath(testCondition.Field); err != "" {
-				testErrors = append(testErrors, err)
-			}
-			// Validate only one of matches/notMatches is defined
-			if testCondition.Matches != "" && testCondition.NotMatches != "" {
-				testErrors = append(testErrors, "only one of matches or notMatches can be defined at once")
-			}
-			// Validate provided regex
-			if testCondition.Matches != "" {
-				_, err = regexp.Compile(testCondition.Matches)
-			} else if testCondition.NotMatches != "" {
-				_, err = regexp.Compile(testCondition.Matches)
-			}
-			if err != nil {
-				testErrors = append(testErrors, "matches/notMatches must be a valid regular expression.")
-			}
-		}
-		if len(testErrors) != 0 {
+		if testErrors := ValidateDropTest(dropTest); len(testErrors) != 0 {
 			results = append(results, fmt.Sprintf("%s: test[%d] %v", filterSpec.Name, i, testErrors))
 		}
 	}
@@ -108,6 +108,254 @@ func validatePruneFilter(filterSpec obs.FilterSpec) (results []string) {
 	return results
 }
 
+// validateParseFilter validates the source and target fields and the format specific options of a parse filter
+func validateParseFilter(filterSpec obs.FilterSpec) (results []string) {
+	spec := filterSpec.ParseFilterSpec
+	if spec == nil {
+		return results
+	}
+	errList := []string{}
+	for _, fieldPath := range []obs.FieldPath{spec.Source, spec.Target} {
+		if fieldPath == "" {
+			continue
+		}
+		if err := validateFieldPath(fieldPath); err != "" {
+			errList = append(errList, err)
+		}
+	}
+	if set.New[obs.FieldPath](".log_type", ".log_source").Has(spec.Target) {
+		errList = append(errList, fmt.Sprintf("%q is a required field and can not be the target", spec.Target))
+	}
+	switch spec.Format {
+	case obs.ParseFormatRegex:
+		if spec.Regex == nil || spec.Regex.Pattern == "" {
+			errList = append(errList, "regex pattern is required for the regex format")
+		} else if re, err := regexp.Compile(spec.Regex.Pattern); err != nil {
+			errList = append(errList, "regex pattern must be a valid regular expression")
+		} else if !hasNamedCapture(re) {
+			errList = append(errList, "regex pattern must contain at least one named capture group")
+		}
+	case obs.ParseFormatCSV:
+		if spec.CSV == nil || len(spec.CSV.Headers) == 0 {
+			errList = append(errList, "csv headers are required for the csv format")
+		}
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateDetectMultilineFilter validates the group by fields and the patterns of a detectMultilineException filter
+func validateDetectMultilineFilter(filterSpec obs.FilterSpec) (results []string) {
+	spec := filterSpec.DetectMultilineExceptionSpec
+	if spec == nil {
+		return results
+	}
+	errList := []string{}
+	for _, fieldPath := range spec.GroupBy {
+		if err := validateFieldPath(fieldPath); err != "" {
+			errList = append(errList, err)
+		}
+	}
+	if patterns := spec.Patterns; patterns != nil {
+		if len(spec.Languages) > 0 {
+			errList = append(errList, "only one of languages or patterns can be defined")
+		}
+		if patterns.StartPattern == "" && patterns.ContinuationPattern == "" && patterns.EndPattern == "" {
+			errList = append(errList, "at least one of startPattern, continuationPattern or endPattern must be defined")
+		}
+		if patterns.EndPattern != "" && (patterns.StartPattern != "" || patterns.ContinuationPattern != "") {
+			errList = append(errList, "endPattern can not be defined with startPattern or continuationPattern")
+		}
+		for name, pattern := range map[string]string{
+			"startPattern":        patterns.StartPattern,
+			"continuationPattern": patterns.ContinuationPattern,
+			"endPattern":          patterns.EndPattern,
+		} {
+			if _, err := regexp.Compile(pattern); err != nil {
+				errList = append(errList, fmt.Sprintf("%s must be a valid regular expression", name))
+			}
+		}
+	}
+	if len(errList) != 0 {
+		sort.Strings(errList)
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateSampleFilter validates the rate, key field and exclude tests of a sample filter
+func validateSampleFilter(filterSpec obs.FilterSpec) (results []string) {
+	spec := filterSpec.SampleFilterSpec
+	if spec == nil {
+		return append(results, fmt.Sprintf("%s sample filter must define a rate", filterSpec.Name))
+	}
+	errList := []string{}
+	if spec.Rate < 1 {
+		errList = append(errList, "rate must be greater than 0")
+	}
+	if spec.KeyField != "" {
+		if err := validateFieldPath(spec.KeyField); err != "" {
+			errList = append(errList, err)
+		}
+	}
+	for i, test := range spec.Exclude {
+		if testErrors := ValidateDropTest(test); len(testErrors) != 0 {
+			errList = append(errList, fmt.Sprintf("exclude[%d] %v", i, testErrors))
+		}
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateDedupeFilter validates the fields, cache size and window of a dedupe filter
+func validateDedupeFilter(filterSpec obs.FilterSpec) (results []string) {
+	spec := filterSpec.DedupeFilterSpec
+	if spec == nil || len(spec.Fields) == 0 {
+		return append(results, fmt.Sprintf("%s dedupe filter must define at least one field", filterSpec.Name))
+	}
+	errList := []string{}
+	for _, fieldPath := range spec.Fields {
+		if err := validateFieldPath(fieldPath); err != "" {
+			errList = append(errList, err)
+		}
+	}
+	if spec.CacheSize != nil && *spec.CacheSize < 1 {
+		errList = append(errList, "cacheSize must be greater than 0")
+	}
+	if spec.WindowMs != nil && *spec.WindowMs < 1 {
+		errList = append(errList, "windowMs must be greater than 0")
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateRedactFilter validates the fields, patterns and replacement of a redact filter
+func validateRedactFilter(filterSpec obs.FilterSpec) (results []string) {
+	spec := filterSpec.RedactFilterSpec
+	if spec == nil || (len(spec.Patterns) == 0 && len(spec.CustomPatterns) == 0) {
+		return append(results, fmt.Sprintf("%s redact filter must define at least one of patterns or customPatterns", filterSpec.Name))
+	}
+	errList := []string{}
+	for _, fieldPath := range spec.Fields {
+		if err := validateFieldPath(fieldPath); err != "" {
+			errList = append(errList, err)
+		}
+		if set.New[obs.FieldPath](".log_type", ".log_source").Has(fieldPath) {
+			errList = append(errList, fmt.Sprintf("%q is a required field and can not be redacted", fieldPath))
+		}
+	}
+	for i, pattern := range spec.CustomPatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			errList = append(errList, fmt.Sprintf("customPatterns[%d] must be a valid regular expression", i))
+		}
+	}
+	if replacement := spec.Replacement; replacement != nil {
+		if replacement.Type == obs.RedactReplacementHash && (replacement.Salt == nil || replacement.Salt.SecretName == "" || replacement.Salt.Key == "") {
+			errList = append(errList, "salt is required for the hash replacement")
+		}
+		if replacement.Type != obs.RedactReplacementHash && replacement.Salt != nil {
+			errList = append(errList, "salt is only supported for the hash replacement")
+		}
+		if replacement.RevealLength != nil {
+			if replacement.Type != obs.RedactReplacementPartial {
+				errList = append(errList, "revealLength is only supported for the partial replacement")
+			} else if *replacement.RevealLength < 1 {
+				errList = append(errList, "revealLength must be greater than 0")
+			}
+		}
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateModifyFilter validates the fields, sources and values of the operations of a modify filter
+func validateModifyFilter(filterSpec obs.FilterSpec) (results []string) {
+	spec := filterSpec.ModifyFilterSpec
+	if spec == nil || len(spec.Operations) == 0 {
+		return append(results, fmt.Sprintf("%s modify filter must define at least one operation", filterSpec.Name))
+	}
+	requiredFields := set.New[obs.FieldPath](".log_type", ".log_source")
+	errList := []string{}
+	for i, op := range spec.Operations {
+		opErrors := []string{}
+		if err := validateFieldPath(op.Field); err != "" {
+			opErrors = append(opErrors, err)
+		}
+		if requiredFields.Has(op.Field) {
+			opErrors = append(opErrors, fmt.Sprintf("%q is a required field and can not be modified", op.Field))
+		}
+		switch op.Type {
+		case obs.ModifyOperationAdd:
+			if (op.Value == "") == (op.ValueFrom == nil) {
+				opErrors = append(opErrors, "exactly one of value or valueFrom is required")
+			}
+			if op.Value != "" && !common.TemplateRegex.MatchString(op.Value) {
+				opErrors = append(opErrors, fmt.Sprintf("value %q must be a valid template", op.Value))
+			}
+		case obs.ModifyOperationCopy, obs.ModifyOperationRename, obs.ModifyOperationMove:
+			if op.Source == "" {
+				opErrors = append(opErrors, "source is required")
+			} else if err := validateFieldPath(op.Source); err != "" {
+				opErrors = append(opErrors, err)
+			} else if op.Type != obs.ModifyOperationCopy && requiredFields.Has(op.Source) {
+				opErrors = append(opErrors, fmt.Sprintf("%q is a required field and can not be removed", op.Source))
+			}
+		case obs.ModifyOperationCoerce:
+			if op.CoerceTo == "" {
+				opErrors = append(opErrors, "coerceTo is required")
+			}
+		}
+		if len(opErrors) != 0 {
+			errList = append(errList, fmt.Sprintf("operations[%d] %v", i, opErrors))
+		}
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+func hasNamedCapture(re *regexp.Regexp) bool {
+	for _, name := range re.SubexpNames() {
+		if name != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateDropTest validates the conditions of a test for their field path and regular expression
+func ValidateDropTest(dropTest obs.DropTest) (testErrors []string) {
+	for _, testCondition := range dropTest.DropConditions {
+		if err := validateFieldPath(testCondition.Field); err != "" {
+			testErrors = append(testErrors, err)
+		}
+		// Validate only one of matches/notMatches is defined
+		if testCondition.Matches != "" && testCondition.NotMatches != "" {
+			testErrors = append(testErrors, "only one of matches or notMatches can be defined at once")
+		}
+		// Validate provided regex
+		var err error
+		if testCondition.Matches != "" {
+			_, err = regexp.Compile(testCondition.Matches)
+		} else if testCondition.NotMatches != "" {
+			_, err = regexp.Compile(testCondition.NotMatches)
+		}
+		if err != nil {
+			testErrors = append(testErrors, "matches/notMatches must be a valid regular expression.")
+		}
+	}
+	return testErrors
+}
+
 // validateFieldPath validates a field path for correctness
 func validateFieldPath(fieldPath obs.FieldPath) string {
 	path := string(fieldPath)
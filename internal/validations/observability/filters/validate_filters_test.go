@@ -1,10 +1,15 @@
 package filters
 
 import (
+	"encoding/json"
+	"os"
+	"os/exec"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
 )
 
@@ -12,6 +17,13 @@ var _ = Describe("[internal][validations][observability][filters]", func() {
 	const (
 		myDrop             = "dropFilter"
 		myPrune            = "pruneFilter"
+		myParse            = "parseFilter"
+		myMultiline        = "multilineFilter"
+		myRemap            = "remapFilter"
+		mySample           = "sampleFilter"
+		myDedupe           = "dedupeFilter"
+		myRedact           = "redactFilter"
+		myModify           = "modifyFilter"
 		expConditionTypeRE = obs.ConditionTypeValidFilterPrefix + "-.*"
 	)
 
@@ -347,4 +359,493 @@ var _ = Describe("[internal][validations][observability][filters]", func() {
 		})
 
 	})
+
+	Context("#validateParseFilter", func() {
+		DescribeTable("invalid parse filter spec", func(parseSpec obs.ParseFilterSpec, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:            myParse,
+				Type:            obs.FilterTypeParse,
+				ParseFilterSpec: &parseSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+		},
+			Entry("should fail validation if the source is not a valid path expression",
+				obs.ParseFilterSpec{Source: "message"},
+				"must start with a '.'",
+			),
+			Entry("should fail validation if the target is a required field",
+				obs.ParseFilterSpec{Target: ".log_type"},
+				"is a required field and can not be the target",
+			),
+			Entry("should fail validation if the regex is not defined for the regex format",
+				obs.ParseFilterSpec{Format: obs.ParseFormatRegex},
+				"regex pattern is required",
+			),
+			Entry("should fail validation if the regex is invalid",
+				obs.ParseFilterSpec{Format: obs.ParseFormatRegex, Regex: &obs.ParseRegex{Pattern: "(?P<level>"}},
+				"regex pattern must be a valid regular expression",
+			),
+			Entry("should fail validation if the regex has no named capture groups",
+				obs.ParseFilterSpec{Format: obs.ParseFormatRegex, Regex: &obs.ParseRegex{Pattern: `^(\w+) (.*)$`}},
+				"regex pattern must contain at least one named capture group",
+			),
+			Entry("should fail validation if the csv headers are not defined for the csv format",
+				obs.ParseFilterSpec{Format: obs.ParseFormatCSV, CSV: &obs.ParseCSV{}},
+				"csv headers are required",
+			),
+		)
+
+		DescribeTable("valid parse filter spec", func(parseSpec *obs.ParseFilterSpec) {
+			spec := obs.FilterSpec{
+				Name:            myParse,
+				Type:            obs.FilterTypeParse,
+				ParseFilterSpec: parseSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `filter.*is valid`))
+		},
+			Entry("should pass validation without a spec", nil),
+			Entry("should pass validation for a regex with named capture groups",
+				&obs.ParseFilterSpec{
+					Format: obs.ParseFormatRegex,
+					Source: ".message",
+					Target: `.kubernetes."parsed-message"`,
+					Regex:  &obs.ParseRegex{Pattern: `^(?P<level>\w+) (?P<msg>.*)$`},
+				},
+			),
+			Entry("should pass validation for csv with headers",
+				&obs.ParseFilterSpec{
+					Format: obs.ParseFormatCSV,
+					CSV:    &obs.ParseCSV{Headers: []string{"a", "b"}},
+				},
+			),
+		)
+	})
+
+	Context("#validateDetectMultilineFilter", func() {
+		DescribeTable("invalid detectMultilineException filter spec", func(multilineSpec obs.DetectMultilineExceptionSpec, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:                         myMultiline,
+				Type:                         obs.FilterTypeDetectMultiline,
+				DetectMultilineExceptionSpec: &multilineSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+		},
+			Entry("should fail validation if a group by field is not a valid path expression",
+				obs.DetectMultilineExceptionSpec{GroupBy: []obs.FieldPath{"kubernetes.pod_name"}},
+				"must start with a '.'",
+			),
+			Entry("should fail validation if languages and patterns are both defined",
+				obs.DetectMultilineExceptionSpec{
+					Languages: []obs.MultilineExceptionLanguage{obs.MultilineExceptionLanguageJava},
+					Patterns:  &obs.MultilinePatterns{StartPattern: "^start"},
+				},
+				"only one of languages or patterns can be defined",
+			),
+			Entry("should fail validation if no patterns are defined",
+				obs.DetectMultilineExceptionSpec{Patterns: &obs.MultilinePatterns{}},
+				"at least one of startPattern, continuationPattern or endPattern must be defined",
+			),
+			Entry("should fail validation if the end pattern is defined with the start pattern",
+				obs.DetectMultilineExceptionSpec{Patterns: &obs.MultilinePatterns{StartPattern: "^start", EndPattern: "end$"}},
+				"endPattern can not be defined with startPattern or continuationPattern",
+			),
+			Entry("should fail validation if a pattern is not a valid regular expression",
+				obs.DetectMultilineExceptionSpec{Patterns: &obs.MultilinePatterns{ContinuationPattern: "^[ "}},
+				"continuationPattern must be a valid regular expression",
+			),
+		)
+
+		DescribeTable("valid detectMultilineException filter spec", func(multilineSpec *obs.DetectMultilineExceptionSpec) {
+			spec := obs.FilterSpec{
+				Name:                         myMultiline,
+				Type:                         obs.FilterTypeDetectMultiline,
+				DetectMultilineExceptionSpec: multilineSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `filter.*is valid`))
+		},
+			Entry("should pass validation without a spec", nil),
+			Entry("should pass validation with languages and group by fields",
+				&obs.DetectMultilineExceptionSpec{
+					Languages: []obs.MultilineExceptionLanguage{obs.MultilineExceptionLanguageGo},
+					GroupBy:   []obs.FieldPath{".kubernetes.pod_id", ".stream"},
+				},
+			),
+			Entry("should pass validation with start and continuation patterns",
+				&obs.DetectMultilineExceptionSpec{
+					Patterns: &obs.MultilinePatterns{StartPattern: `^\d{4}-`, ContinuationPattern: `^\s+`},
+				},
+			),
+		)
+	})
+
+	Context("#validateRemapFilter", func() {
+		var (
+			vectorValidate = runVectorValidate
+			// compilerErrors is the output of `vector validate` for the programs the compiler rejects
+			compilerErrors = map[string]string{
+				".a = upcase(.b)": `Failed to load ["/tmp/remap.json"]
+------------------------------------
+x Transform "remap":
+error[E103]: unhandled fallible assignment
+  ┌─ :1:6
+  │
+1 │ .a = upcase(.b)
+  │ ---- ^^^^^^^^^^
+  │ │    │
+  │ │    this expression is fallible because at least one argument's type cannot be verified to be valid
+  │ or change this to an infallible assignment:
+  │ .a, err = upcase(.b)
+`,
+				".foo = \"bar\"\n.foo =": `Failed to load ["/tmp/remap.json"]
+------------------------------------
+x Transform "remap":
+error[E203]: syntax error
+  ┌─ :2:7
+  │
+2 │ .foo =
+  │       ^ unexpected end of program
+`,
+				".foo = bar": "unexpected output",
+			}
+		)
+		BeforeEach(func() {
+			compiledVRL = map[string]string{}
+			runVectorValidate = func(configFile string) (string, error) {
+				content, err := os.ReadFile(configFile)
+				Expect(err).To(BeNil())
+				config := struct {
+					Transforms map[string]struct {
+						Source string `json:"source"`
+					} `json:"transforms"`
+				}{}
+				Expect(json.Unmarshal(content, &config)).To(Succeed())
+				if out, found := compilerErrors[config.Transforms["remap"].Source]; found {
+					return out, exec.Command("false").Run()
+				}
+				return "", nil
+			}
+		})
+		AfterEach(func() {
+			runVectorValidate = vectorValidate
+		})
+
+		DescribeTable("invalid vrl", func(vrl, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:            myRemap,
+				Type:            obs.FilterTypeRemap,
+				RemapFilterSpec: &obs.RemapFilterSpec{VRL: vrl},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+		},
+			Entry("should fail validation if the vrl is empty", " ", "remap filter must define vrl"),
+			Entry("should fail validation for an unclosed brace",
+				".foo = \"bar\"\nif .level == \"debug\" {\n  .debug = true\n",
+				`line 2, column 22: unclosed "{"`),
+			Entry("should fail validation for an unexpected parenthesis",
+				".foo = upcase(.bar))",
+				`line 1, column 20: unexpected "\)"`),
+			Entry("should fail validation for an unterminated string",
+				".foo = \"bar",
+				"line 1, column 8: unterminated string literal"),
+			Entry("should fail validation when assigning the log_type",
+				".foo = 1\n  .log_type = \"application\"",
+				`line 2, column 3: writing to reserved field "\.log_type" is not allowed`),
+			Entry("should fail validation when assigning the log_source with an error",
+				".log_source, err = \"node\"",
+				`line 1, column 1: writing to reserved field "\.log_source" is not allowed`),
+			Entry("should fail validation when assigning a kubernetes field",
+				`.kubernetes.labels."app.kubernetes.io/name" = "foo"`,
+				`line 1, column 1: writing to reserved field.*is not allowed`),
+			Entry("should fail validation when deleting a kubernetes field",
+				"del(.kubernetes.labels)",
+				`line 1, column 5: writing to reserved field "\.kubernetes\.labels" is not allowed`),
+			Entry("should fail validation for a fallible call which is not handled",
+				".a = upcase(.b)",
+				`invalid vrl at line 1, column 6: unhandled fallible assignment`),
+			Entry("should fail validation for an incomplete assignment",
+				".foo = \"bar\"\n.foo =",
+				`invalid vrl at line 2, column 7: syntax error`),
+			Entry("should fail validation when the compiler output can not be parsed",
+				".foo = bar",
+				`invalid vrl: rejected by the collector`),
+		)
+
+		DescribeTable("valid vrl", func(vrl string) {
+			spec := obs.FilterSpec{
+				Name:            myRemap,
+				Type:            obs.FilterTypeRemap,
+				RemapFilterSpec: &obs.RemapFilterSpec{VRL: vrl},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `filter.*is valid`))
+		},
+			Entry("should pass validation when reading reserved fields", `
+if .log_type == "application" && .kubernetes.namespace_name != "default" {
+  .service = .kubernetes.labels.app
+}`),
+			Entry("should pass validation when reserved fields and brackets are in strings and comments", `
+# .log_type = "foo" (
+.message = "set .log_type = {"
+.pattern = r'^\('`),
+			Entry("should pass validation when writing to fields of variables", `
+parsed = {}
+parsed.kubernetes = .kubernetes
+.copy = parsed`),
+		)
+	})
+
+	Context("#validateSampleFilter", func() {
+		DescribeTable("invalid sample filter spec", func(sampleSpec *obs.SampleFilterSpec, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:             mySample,
+				Type:             obs.FilterTypeSample,
+				SampleFilterSpec: sampleSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+		},
+			Entry("should fail validation without a spec", nil, "sample filter must define a rate"),
+			Entry("should fail validation if the rate is not positive",
+				&obs.SampleFilterSpec{Rate: 0},
+				"rate must be greater than 0",
+			),
+			Entry("should fail validation if the key field is not a valid path expression",
+				&obs.SampleFilterSpec{Rate: 10, KeyField: "kubernetes.pod_name"},
+				"must start with a '.'",
+			),
+			Entry("should fail validation if an exclude test is invalid",
+				&obs.SampleFilterSpec{
+					Rate: 10,
+					Exclude: []obs.DropTest{
+						{DropConditions: []obs.DropCondition{{Field: ".message", Matches: "error("}}},
+					},
+				},
+				`exclude\[0\] \[matches/notMatches must be a valid regular expression.\]`,
+			),
+		)
+
+		It("should pass validation with a rate, key field and exclude tests", func() {
+			spec := obs.FilterSpec{
+				Name: mySample,
+				Type: obs.FilterTypeSample,
+				SampleFilterSpec: &obs.SampleFilterSpec{
+					Rate:     10,
+					KeyField: ".kubernetes.pod_name",
+					Exclude: []obs.DropTest{
+						{DropConditions: []obs.DropCondition{{Field: ".kubernetes.namespace_name", Matches: "payments"}}},
+					},
+				},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `filter.*is valid`))
+		})
+	})
+
+	Context("#validateDedupeFilter", func() {
+		DescribeTable("invalid dedupe filter spec", func(dedupeSpec *obs.DedupeFilterSpec, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:             myDedupe,
+				Type:             obs.FilterTypeDedupe,
+				DedupeFilterSpec: dedupeSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+		},
+			Entry("should fail validation without a spec", nil, "dedupe filter must define at least one field"),
+			Entry("should fail validation without fields", &obs.DedupeFilterSpec{}, "dedupe filter must define at least one field"),
+			Entry("should fail validation if a field is not a valid path expression",
+				&obs.DedupeFilterSpec{Fields: []obs.FieldPath{"message"}},
+				"must start with a '.'",
+			),
+			Entry("should fail validation if the cache size is not positive",
+				&obs.DedupeFilterSpec{Fields: []obs.FieldPath{".message"}, CacheSize: utils.GetPtr(int64(0))},
+				"cacheSize must be greater than 0",
+			),
+			Entry("should fail validation if the window is not positive",
+				&obs.DedupeFilterSpec{Fields: []obs.FieldPath{".message"}, WindowMs: utils.GetPtr(int64(-1))},
+				"windowMs must be greater than 0",
+			),
+		)
+
+		It("should pass validation with fields, a cache size and a window", func() {
+			spec := obs.FilterSpec{
+				Name: myDedupe,
+				Type: obs.FilterTypeDedupe,
+				DedupeFilterSpec: &obs.DedupeFilterSpec{
+					Fields:    []obs.FieldPath{".message", `.kubernetes.labels."app.kubernetes.io/name"`},
+					CacheSize: utils.GetPtr(int64(100)),
+					WindowMs:  utils.GetPtr(int64(60000)),
+				},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `filter.*is valid`))
+		})
+	})
+
+	Context("#validateRedactFilter", func() {
+		DescribeTable("invalid redact filters", func(redactSpec *obs.RedactFilterSpec, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:             myRedact,
+				Type:             obs.FilterTypeRedact,
+				RedactFilterSpec: redactSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+		},
+			Entry("should fail validation without a spec", nil, "redact filter must define at least one of patterns or customPatterns"),
+			Entry("should fail validation without patterns", &obs.RedactFilterSpec{}, "redact filter must define at least one of patterns or customPatterns"),
+			Entry("should fail validation if a field is not a valid path expression",
+				&obs.RedactFilterSpec{Fields: []obs.FieldPath{"message"}, Patterns: []obs.RedactPattern{obs.RedactPatternEmail}},
+				"must start with a '.'",
+			),
+			Entry("should fail validation if a field is required",
+				&obs.RedactFilterSpec{Fields: []obs.FieldPath{".log_type"}, Patterns: []obs.RedactPattern{obs.RedactPatternEmail}},
+				"is a required field and can not be redacted",
+			),
+			Entry("should fail validation if a custom pattern is not a valid regular expression",
+				&obs.RedactFilterSpec{CustomPatterns: []string{"secret=(", "token"}},
+				`customPatterns\[0\] must be a valid regular expression`,
+			),
+			Entry("should fail validation if the hash replacement has no salt",
+				&obs.RedactFilterSpec{
+					Patterns:    []obs.RedactPattern{obs.RedactPatternEmail},
+					Replacement: &obs.RedactReplacement{Type: obs.RedactReplacementHash},
+				},
+				"salt is required for the hash replacement",
+			),
+			Entry("should fail validation if a salt is spec'd without the hash replacement",
+				&obs.RedactFilterSpec{
+					Patterns: []obs.RedactPattern{obs.RedactPatternEmail},
+					Replacement: &obs.RedactReplacement{
+						Type: obs.RedactReplacementMask,
+						Salt: &obs.SecretReference{SecretName: "salt", Key: "salt"},
+					},
+				},
+				"salt is only supported for the hash replacement",
+			),
+			Entry("should fail validation if a reveal length is spec'd without the partial replacement",
+				&obs.RedactFilterSpec{
+					Patterns:    []obs.RedactPattern{obs.RedactPatternEmail},
+					Replacement: &obs.RedactReplacement{Type: obs.RedactReplacementMask, RevealLength: utils.GetPtr(int64(4))},
+				},
+				"revealLength is only supported for the partial replacement",
+			),
+			Entry("should fail validation if the reveal length is not positive",
+				&obs.RedactFilterSpec{
+					Patterns:    []obs.RedactPattern{obs.RedactPatternCreditCard},
+					Replacement: &obs.RedactReplacement{Type: obs.RedactReplacementPartial, RevealLength: utils.GetPtr(int64(0))},
+				},
+				"revealLength must be greater than 0",
+			),
+		)
+
+		It("should pass validation with fields, patterns and a hash replacement", func() {
+			spec := obs.FilterSpec{
+				Name: myRedact,
+				Type: obs.FilterTypeRedact,
+				RedactFilterSpec: &obs.RedactFilterSpec{
+					Fields:         []obs.FieldPath{".message", `.structured."user-email"`},
+					Patterns:       []obs.RedactPattern{obs.RedactPatternEmail, obs.RedactPatternIPv4},
+					CustomPatterns: []string{`password=\S+`},
+					Replacement: &obs.RedactReplacement{
+						Type: obs.RedactReplacementHash,
+						Salt: &obs.SecretReference{SecretName: "redact-salt", Key: "salt"},
+					},
+				},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `filter.*is valid`))
+		})
+	})
+
+	Context("#validateModifyFilter", func() {
+		DescribeTable("invalid modify filters", func(operations []obs.ModifyOperation, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:             myModify,
+				Type:             obs.FilterTypeModify,
+				ModifyFilterSpec: &obs.ModifyFilterSpec{Operations: operations},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+		},
+			Entry("should fail validation without operations", nil, "modify filter must define at least one operation"),
+			Entry("should fail validation if a field is not a valid path expression",
+				[]obs.ModifyOperation{{Type: obs.ModifyOperationAdd, Field: "env", Value: "prod"}},
+				`operations\[0\] \[.*must start with a '.'`,
+			),
+			Entry("should fail validation if a field is required",
+				[]obs.ModifyOperation{{Type: obs.ModifyOperationAdd, Field: ".log_type", Value: "audit"}},
+				"is a required field and can not be modified",
+			),
+			Entry("should fail validation if add has both a value and valueFrom",
+				[]obs.ModifyOperation{{Type: obs.ModifyOperationAdd, Field: ".env", Value: "prod", ValueFrom: &obs.ValueReference{ConfigMapName: "info", Key: "env"}}},
+				"exactly one of value or valueFrom is required",
+			),
+			Entry("should fail validation if add has an invalid template",
+				[]obs.ModifyOperation{{Type: obs.ModifyOperationAdd, Field: ".env", Value: "{.env}"}},
+				"must be a valid template",
+			),
+			Entry("should fail validation if copy has no source",
+				[]obs.ModifyOperation{{Type: obs.ModifyOperationCopy, Field: ".service"}},
+				"source is required",
+			),
+			Entry("should fail validation if the source is not a valid path expression",
+				[]obs.ModifyOperation{{Type: obs.ModifyOperationRename, Field: ".service", Source: ".kubernetes.labels.app/name"}},
+				"must be a valid dot delimited path expression",
+			),
+			Entry("should fail validation if a required field is moved",
+				[]obs.ModifyOperation{{Type: obs.ModifyOperationMove, Field: ".source", Source: ".log_source"}},
+				"is a required field and can not be removed",
+			),
+			Entry("should fail validation if coerce has no type",
+				[]obs.ModifyOperation{{Type: obs.ModifyOperationCoerce, Field: ".status"}},
+				"coerceTo is required",
+			),
+		)
+
+		It("should pass validation with each of the operations", func() {
+			spec := obs.FilterSpec{
+				Name: myModify,
+				Type: obs.FilterTypeModify,
+				ModifyFilterSpec: &obs.ModifyFilterSpec{
+					Operations: []obs.ModifyOperation{
+						{Type: obs.ModifyOperationAdd, Field: ".env", ValueFrom: &obs.ValueReference{ConfigMapName: "info", Key: "env"}},
+						{Type: obs.ModifyOperationAdd, Field: ".team", Value: `team-{.kubernetes.labels.team||"none"}`},
+						{Type: obs.ModifyOperationCopy, Field: ".source", Source: ".log_source"},
+						{Type: obs.ModifyOperationRename, Field: ".service", Source: `.kubernetes.labels."app.kubernetes.io/name"`},
+						{Type: obs.ModifyOperationMove, Field: ".request.id", Source: ".structured.request_id"},
+						{Type: obs.ModifyOperationCoerce, Field: ".structured.status", CoerceTo: obs.ModifyCoerceInteger},
+					},
+				},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `filter.*is valid`))
+		})
+	})
+
+	Context("#when", func() {
+		DescribeTable("invalid when tests", func(condition obs.DropCondition, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:            "myLabels",
+				Type:            obs.FilterTypeOpenshiftLabels,
+				OpenshiftLabels: map[string]string{"team": "my-team"},
+				When: []obs.DropTest{
+					{DropConditions: []obs.DropCondition{condition}},
+				},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+		},
+			Entry("should fail validation if a field is not a valid path expression",
+				obs.DropCondition{Field: "kubernetes.namespace_name", Matches: "my-app"},
+				`when\[0\] \[.*must start with a '.'`,
+			),
+			Entry("should fail validation if a regular expression is not valid",
+				obs.DropCondition{Field: ".kubernetes.namespace_name", Matches: "my-app("},
+				`when\[0\] \[matches/notMatches must be a valid regular expression`,
+			),
+		)
+
+		It("should pass validation with valid when tests", func() {
+			spec := obs.FilterSpec{
+				Name: "myAudit",
+				Type: obs.FilterTypeKubeAPIAudit,
+				When: []obs.DropTest{
+					{DropConditions: []obs.DropCondition{{Field: ".log_source", Matches: "^kubeAPI$"}}},
+				},
+				KubeAPIAudit: &obs.KubeAPIAudit{},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `filter.*is valid`))
+		})
+	})
 })
@@ -0,0 +1,265 @@
+package filters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+const (
+	// VectorBinary is the collector binary shipped with the operator which compiles the VRL of remap filters
+	VectorBinary = "/usr/bin/vector"
+
+	vrlCompileTimeout = 30 * time.Second
+)
+
+var (
+	// reservedFields are required by the normalization filters which follow user filters in a pipeline. The
+	// generated remap restores them after the user VRL runs, direct writes are rejected to surface the mistake early
+	reservedFields = `(?:log_type|log_source|kubernetes)`
+	reservedPath   = `\.` + reservedFields + `(?:\.[a-zA-Z0-9_@]+|\."[^"\n]*"|\[[^\]\n]*\])*`
+
+	// Matches assignments (e.g. `.log_type = "foo"`, `.kubernetes.labels.app, err = ...`, `.log_source |= {}`)
+	// that are not part of a variable path or comparison
+	reservedAssignmentRegex = regexp.MustCompile(`(?m)(?:^|[^a-zA-Z0-9_."\])])(` + reservedPath + `)(?:\s*,\s*[a-zA-Z_][a-zA-Z0-9_]*)?[ \t]*\|?=(?:[^=]|$)`)
+
+	// Matches deletions (e.g. `del(.kubernetes.labels)`)
+	reservedDeletionRegex = regexp.MustCompile(`\bdel!?\(\s*(` + reservedPath + `)`)
+
+	// Match the first diagnostic of the VRL compiler (e.g. `error[E103]: unhandled fallible assignment`) and
+	// its location in the program (e.g. `┌─ :1:6`)
+	vrlDiagnosticRegex = regexp.MustCompile(`(?m)^error\[E[0-9]+\]: (.+)$`)
+	vrlLocationRegex   = regexp.MustCompile(`┌─ [^\n]*?:([0-9]+):([0-9]+)`)
+
+	// runVectorValidate runs `vector validate` for a config file and returns its output and error
+	runVectorValidate = func(configFile string) (string, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), vrlCompileTimeout)
+		defer cancel()
+		out, err := exec.CommandContext(ctx, VectorBinary, "validate", "--no-environment", "--config-json", configFile).CombinedOutput()
+		if ctx.Err() != nil {
+			return string(out), ctx.Err()
+		}
+		return string(out), err
+	}
+
+	// compiledVRL caches the result of the compilation of each program to avoid running the collector at every reconciliation
+	compiledVRL      = map[string]string{}
+	compiledVRLMutex sync.Mutex
+
+	closingBrackets = map[byte]byte{
+		')': '(',
+		']': '[',
+		'}': '{',
+	}
+)
+
+// vrlError is an error found at a position of a VRL program
+type vrlError struct {
+	offset  int
+	message string
+}
+
+// validateRemapFilter checks the VRL of a remap filter for direct writes to reserved fields and compiles it
+// with the collector
+func validateRemapFilter(filterSpec obs.FilterSpec) (results []string) {
+	if filterSpec.RemapFilterSpec == nil || strings.TrimSpace(filterSpec.RemapFilterSpec.VRL) == "" {
+		return append(results, fmt.Sprintf("%s remap filter must define vrl", filterSpec.Name))
+	}
+	err := validateVRL(filterSpec.RemapFilterSpec.VRL)
+	if err == "" {
+		err = compileVRL(filterSpec.RemapFilterSpec.VRL)
+	}
+	if err != "" {
+		results = append(results, fmt.Sprintf("%s: %s", filterSpec.Name, err))
+	}
+	return results
+}
+
+// compileVRL compiles a VRL program with the collector and returns the first compiler error, with its line and
+// column, or an empty string. The program is compiled in a remap transform of a config which is validated without
+// the environment of the collector
+func compileVRL(vrl string) string {
+	compiledVRLMutex.Lock()
+	defer compiledVRLMutex.Unlock()
+	if result, found := compiledVRL[vrl]; found {
+		return result
+	}
+	result, cache := runVRLCompiler(vrl)
+	if cache {
+		compiledVRL[vrl] = result
+	}
+	return result
+}
+
+// runVRLCompiler compiles a VRL program with the collector. The result is not cacheable when the compiler could not run
+func runVRLCompiler(vrl string) (string, bool) {
+	config, err := json.Marshal(map[string]interface{}{
+		"sources": map[string]interface{}{
+			"input": map[string]interface{}{"type": "demo_logs", "format": "json"},
+		},
+		"transforms": map[string]interface{}{
+			"remap": map[string]interface{}{"type": "remap", "inputs": []string{"input"}, "source": vrl},
+		},
+		"sinks": map[string]interface{}{
+			"output": map[string]interface{}{"type": "blackhole", "inputs": []string{"remap"}},
+		},
+	})
+	if err != nil {
+		return fmt.Sprintf("unable to compile vrl: %v", err), false
+	}
+	file, err := os.CreateTemp("", "remap-*.json")
+	if err != nil {
+		return fmt.Sprintf("unable to compile vrl: %v", err), false
+	}
+	defer os.Remove(file.Name())
+	_, err = file.Write(config)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Sprintf("unable to compile vrl: %v", err), false
+	}
+	out, err := runVectorValidate(file.Name())
+	if err == nil {
+		return "", true
+	}
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return fmt.Sprintf("unable to compile vrl: %v", err), false
+	}
+	return vrlCompilerError(out), true
+}
+
+// vrlCompilerError formats the first diagnostic of the output of `vector validate`
+func vrlCompilerError(out string) string {
+	diagnostic := vrlDiagnosticRegex.FindStringSubmatch(out)
+	if diagnostic == nil {
+		log.V(3).Info("Unexpected output of the VRL compiler", "output", out)
+		return "invalid vrl: rejected by the collector"
+	}
+	message := strings.TrimSpace(diagnostic[1])
+	rest := out[strings.Index(out, diagnostic[0]):]
+	if location := vrlLocationRegex.FindStringSubmatch(rest); location != nil {
+		line, _ := strconv.Atoi(location[1])
+		column, _ := strconv.Atoi(location[2])
+		return fmt.Sprintf("invalid vrl at line %d, column %d: %s", line, column, message)
+	}
+	return fmt.Sprintf("invalid vrl: %s", message)
+}
+
+// validateVRL returns the first unbalanced delimiter or direct write to a reserved field of a VRL program, with its
+// line and column, or an empty string
+func validateVRL(vrl string) string {
+	masked, errs := maskVRL(vrl)
+	errs = append(errs, checkBrackets(masked)...)
+	for _, re := range []*regexp.Regexp{reservedAssignmentRegex, reservedDeletionRegex} {
+		for _, match := range re.FindAllStringSubmatchIndex(masked, -1) {
+			path := vrl[match[2]:match[3]]
+			errs = append(errs, vrlError{offset: match[2], message: fmt.Sprintf("writing to reserved field %q is not allowed", path)})
+		}
+	}
+	if len(errs) == 0 {
+		return ""
+	}
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].offset < errs[j].offset
+	})
+	line, column := position(vrl, errs[0].offset)
+	return fmt.Sprintf("invalid vrl at line %d, column %d: %s", line, column, errs[0].message)
+}
+
+// maskVRL replaces the content of comments and string literals with spaces while preserving their delimiters
+// and the position of every other character
+func maskVRL(vrl string) (string, []vrlError) {
+	masked := []byte(vrl)
+	var errs []vrlError
+	for i := 0; i < len(masked); i++ {
+		c := masked[i]
+		switch {
+		case c == '#':
+			for ; i < len(masked) && masked[i] != '\n'; i++ {
+				masked[i] = ' '
+			}
+		case c == '"':
+			if end := maskLiteral(masked, i, '"'); end < 0 {
+				errs = append(errs, vrlError{offset: i, message: "unterminated string literal"})
+				i = len(masked)
+			} else {
+				i = end
+			}
+		case (c == 's' || c == 'r' || c == 't') && i+1 < len(masked) && masked[i+1] == '\'' && (i == 0 || !isIdentifier(masked[i-1])):
+			if end := maskLiteral(masked, i+1, '\''); end < 0 {
+				errs = append(errs, vrlError{offset: i, message: "unterminated string literal"})
+				i = len(masked)
+			} else {
+				i = end
+			}
+		}
+	}
+	return string(masked), errs
+}
+
+// maskLiteral masks the literal starting with the delimiter at start and returns the offset of the closing delimiter or -1
+func maskLiteral(masked []byte, start int, delimiter byte) int {
+	for i := start + 1; i < len(masked); i++ {
+		switch masked[i] {
+		case '\\':
+			masked[i] = ' '
+			if i+1 < len(masked) && masked[i+1] != '\n' {
+				i++
+				masked[i] = ' '
+			}
+		case delimiter:
+			return i
+		case '\n':
+		default:
+			masked[i] = ' '
+		}
+	}
+	return -1
+}
+
+// checkBrackets verifies parentheses, brackets and braces are balanced
+func checkBrackets(masked string) (errs []vrlError) {
+	var stack []int
+	for i := 0; i < len(masked); i++ {
+		c := masked[i]
+		switch c {
+		case '(', '[', '{':
+			stack = append(stack, i)
+		case ')', ']', '}':
+			if len(stack) == 0 || masked[stack[len(stack)-1]] != closingBrackets[c] {
+				return append(errs, vrlError{offset: i, message: fmt.Sprintf("unexpected %q", string(c))})
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+	if len(stack) > 0 {
+		open := stack[len(stack)-1]
+		errs = append(errs, vrlError{offset: open, message: fmt.Sprintf("unclosed %q", string(masked[open]))})
+	}
+	return errs
+}
+
+func isIdentifier(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// position returns the 1-based line and column of an offset
+func position(vrl string, offset int) (line, column int) {
+	line = strings.Count(vrl[:offset], "\n") + 1
+	column = offset - strings.LastIndex(vrl[:offset], "\n")
+	return line, column
+}
@@ -0,0 +1,151 @@
+package filters
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Validating the secrets and configmaps of filters", func() {
+
+	var (
+		saltSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "redact-salt",
+			},
+			Data: map[string][]byte{
+				"salt":    []byte("pepper"),
+				"base64":  []byte("c2FsdC1hbmQtcGVwcGVy+/=="),
+				"escaped": []byte(`pep"per\`),
+				"none":    {},
+			},
+		}
+	)
+
+	DescribeTable("#Validate", func(key string, status bool, message string) {
+		context := internalcontext.ForwarderContext{
+			Forwarder: &obs.ClusterLogForwarder{
+				Spec: obs.ClusterLogForwarderSpec{
+					Filters: []obs.FilterSpec{
+						{
+							Name: "myRedact",
+							Type: obs.FilterTypeRedact,
+							RedactFilterSpec: &obs.RedactFilterSpec{
+								Patterns: []obs.RedactPattern{obs.RedactPatternEmail},
+								Replacement: &obs.RedactReplacement{
+									Type: obs.RedactReplacementHash,
+									Salt: &obs.SecretReference{SecretName: saltSecret.Name, Key: key},
+								},
+							},
+						},
+					},
+				},
+			},
+			Secrets: map[string]*corev1.Secret{
+				saltSecret.Name: saltSecret,
+			},
+		}
+		Validate(context)
+		reason := obs.ReasonValidationSuccess
+		if !status {
+			reason = obs.ReasonValidationFailure
+		}
+		Expect(context.Forwarder.Status.FilterConditions).To(ContainElement(MatchCondition(obs.ConditionTypeValidFilterPrefix+"-.*", status, reason, message)))
+	},
+		Entry("should pass when the salt secret key exists", "salt", true, "is valid"),
+		Entry("should fail when the salt secret key does not exist", "missing", false, `secret\[redact-salt.missing\] not found`),
+		Entry("should fail when the salt secret key is empty", "none", false, `secret\[redact-salt.none\] value is empty`),
+		Entry("should pass when the salt is base64 encoded", "base64", true, "is valid"),
+		Entry("should fail when the salt contains characters which can not be embedded in VRL", "escaped", false, "salt must only contain alphanumeric characters"),
+	)
+
+	DescribeTable("#Validate modify filter values", func(valueFrom obs.ValueReference, status bool, message string) {
+		context := internalcontext.ForwarderContext{
+			Forwarder: &obs.ClusterLogForwarder{
+				Spec: obs.ClusterLogForwarderSpec{
+					Filters: []obs.FilterSpec{
+						{
+							Name: "myModify",
+							Type: obs.FilterTypeModify,
+							ModifyFilterSpec: &obs.ModifyFilterSpec{
+								Operations: []obs.ModifyOperation{
+									{
+										Type:      obs.ModifyOperationAdd,
+										Field:     ".env",
+										ValueFrom: &valueFrom,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Secrets: map[string]*corev1.Secret{
+				"cluster-secret": {
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster-secret"},
+					Data: map[string][]byte{
+						"env":     []byte("prod-us/east 1"),
+						"escaped": []byte(`prod\x00`),
+					},
+				},
+			},
+			ConfigMaps: map[string]*corev1.ConfigMap{
+				"cluster-info": {
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster-info"},
+					Data: map[string]string{
+						"env":     "prod-us/east 1",
+						"quoted":  `prod" + .message + "`,
+						"unicode": "prodé",
+						"newline": "prod\n",
+					},
+				},
+			},
+		}
+		Validate(context)
+		reason := obs.ReasonValidationSuccess
+		if !status {
+			reason = obs.ReasonValidationFailure
+		}
+		Expect(context.Forwarder.Status.FilterConditions).To(ContainElement(MatchCondition(obs.ConditionTypeValidFilterPrefix+"-.*", status, reason, message)))
+	},
+		Entry("should pass when the configmap value is printable ASCII", obs.ValueReference{ConfigMapName: "cluster-info", Key: "env"}, true, "is valid"),
+		Entry("should pass when the secret value is printable ASCII", obs.ValueReference{SecretName: "cluster-secret", Key: "env"}, true, "is valid"),
+		Entry("should fail when the configmap value contains a double quote", obs.ValueReference{ConfigMapName: "cluster-info", Key: "quoted"}, false, `value of .env must only contain printable ASCII characters`),
+		Entry("should fail when the configmap value contains non-ASCII characters", obs.ValueReference{ConfigMapName: "cluster-info", Key: "unicode"}, false, `value of .env must only contain printable ASCII characters`),
+		Entry("should fail when the configmap value contains a newline", obs.ValueReference{ConfigMapName: "cluster-info", Key: "newline"}, false, `value of .env must only contain printable ASCII characters`),
+		Entry("should fail when the secret value contains a backslash", obs.ValueReference{SecretName: "cluster-secret", Key: "escaped"}, false, `value of .env must only contain printable ASCII characters`),
+	)
+
+	It("should fail when the configmap of a modify filter value does not exist", func() {
+		context := internalcontext.ForwarderContext{
+			Forwarder: &obs.ClusterLogForwarder{
+				Spec: obs.ClusterLogForwarderSpec{
+					Filters: []obs.FilterSpec{
+						{
+							Name: "myModify",
+							Type: obs.FilterTypeModify,
+							ModifyFilterSpec: &obs.ModifyFilterSpec{
+								Operations: []obs.ModifyOperation{
+									{
+										Type:      obs.ModifyOperationAdd,
+										Field:     ".env",
+										ValueFrom: &obs.ValueReference{ConfigMapName: "cluster-info", Key: "env"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Secrets:    map[string]*corev1.Secret{},
+			ConfigMaps: map[string]*corev1.ConfigMap{},
+		}
+		Validate(context)
+		Expect(context.Forwarder.Status.FilterConditions).To(ContainElement(MatchCondition(obs.ConditionTypeValidFilterPrefix+"-.*", false, obs.ReasonValidationFailure, `configmap\[cluster-info\] not found`)))
+	})
+})
@@ -5,6 +5,7 @@ import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	. "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/set"
 	"regexp"
 	"strings"
 )
@@ -44,9 +45,23 @@ func ValidateApplication(spec obs.InputSpec) (conditions []metav1.Condition) {
 			}
 		}
 	}
+	var failures []string
 	if len(messages) > 0 {
-		msg := fmt.Sprintf("globs must match %q for: %s", globRE, strings.Join(messages, ","))
-		conditions = append(conditions, NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, msg))
+		failures = append(failures, fmt.Sprintf("globs must match %q for: %s", globRE, strings.Join(messages, ",")))
+	}
+	if spec.Application.NamespaceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(spec.Application.NamespaceSelector); err != nil {
+			failures = append(failures, fmt.Sprintf("invalid namespaceSelector: %v", err))
+		}
+	}
+	if set.New(spec.Application.Streams...).Len() != len(spec.Application.Streams) {
+		failures = append(failures, "streams must be unique")
+	}
+	if tuning := spec.Application.Tuning; tuning != nil && tuning.RateLimitPerContainer != nil && tuning.RateLimitPerContainer.KeyTemplate != "" {
+		failures = append(failures, "keyTemplate is not supported by the per-container rate limit")
+	}
+	if len(failures) > 0 {
+		conditions = append(conditions, NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, strings.Join(failures, ",")))
 	} else {
 		conditions = append(conditions, NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("input %q is valid", spec.Name)))
 	}
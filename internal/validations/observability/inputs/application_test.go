@@ -5,6 +5,7 @@ import (
 	. "github.com/onsi/gomega"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("#ValidateApplication", func() {
@@ -37,6 +38,16 @@ var _ = Describe("#ValidateApplication", func() {
 		Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
 	})
 
+	It("should fail when the per-container rate limit defines a key template", func() {
+		input.Application.Tuning = &obs.ContainerInputTuningSpec{
+			RateLimitPerContainer: &obs.LimitSpec{
+				MaxRecordsPerSecond: 10,
+				KeyTemplate:         `{.kubernetes.namespace_name||"none"}`,
+			},
+		}
+		Expect(ValidateApplication(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "keyTemplate is not supported by the per-container rate limit"))
+	})
+
 	Context("of includes and excludes", func() {
 		It("should fail invalid exclude Namespaces", func() {
 			input.Application.Excludes = []obs.NamespaceContainerSpec{
@@ -116,4 +127,26 @@ var _ = Describe("#ValidateApplication", func() {
 			Expect(ValidateApplication(input)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
 		})
 	})
+
+	Context("of namespace selectors and streams", func() {
+		It("should pass for a valid namespace selector and streams", func() {
+			input.Application.NamespaceSelector = &metav1.LabelSelector{
+				MatchLabels: map[string]string{"logging.example.com/forward": "true"},
+			}
+			input.Application.Streams = []obs.ContainerStream{obs.ContainerStreamStderr}
+			Expect(ValidateApplication(input)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+		It("should fail for an invalid namespace selector", func() {
+			input.Application.NamespaceSelector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "env", Operator: metav1.LabelSelectorOpIn},
+				},
+			}
+			Expect(ValidateApplication(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "invalid namespaceSelector"))
+		})
+		It("should fail for duplicate streams", func() {
+			input.Application.Streams = []obs.ContainerStream{obs.ContainerStreamStderr, obs.ContainerStreamStderr}
+			Expect(ValidateApplication(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "streams must be unique"))
+		})
+	})
 })
@@ -0,0 +1,30 @@
+package inputs
+
+import (
+	"fmt"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ValidateEvents validates an events input which is only collected when the collector is deployed as a deployment
+// to avoid forwarding the same events from every collector of a daemonset
+func ValidateEvents(spec obs.InputSpec, forwarder obs.ClusterLogForwarder) []metav1.Condition {
+	if spec.Type != obs.InputTypeKubernetesEvents {
+		return nil
+	}
+
+	if spec.Events == nil {
+		return []metav1.Condition{
+			NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonMissingSpec, fmt.Sprintf("%s has nil events spec", spec.Name)),
+		}
+	}
+	if !DeployAsDeployment(forwarder) {
+		return []metav1.Condition{
+			NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s requires the collector to be deployed as a deployment", spec.Name)),
+		}
+	}
+	return []metav1.Condition{
+		NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("input %q is valid", spec.Name)),
+	}
+}
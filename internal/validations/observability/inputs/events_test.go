@@ -0,0 +1,47 @@
+package inputs
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("#ValidateEvents", func() {
+
+	var (
+		input              obs.InputSpec
+		forwarder          obs.ClusterLogForwarder
+		expConditionTypeRE = obs.ConditionTypeValidInputPrefix + "-.*"
+	)
+	BeforeEach(func() {
+		input = obs.InputSpec{
+			Name:   "myevents",
+			Type:   obs.InputTypeKubernetesEvents,
+			Events: &obs.KubernetesEvents{},
+		}
+		forwarder = obs.ClusterLogForwarder{}
+		forwarder.Annotations = map[string]string{constants.AnnotationEnableCollectorAsDeployment: "true"}
+		forwarder.Spec.Inputs = []obs.InputSpec{input}
+	})
+	It("should skip the validation when not an events type", func() {
+		input.Type = obs.InputTypeApplication
+		Expect(ValidateEvents(input, forwarder)).To(BeEmpty())
+	})
+	It("should fail when an events type but has no events input", func() {
+		input.Events = nil
+		Expect(ValidateEvents(input, forwarder)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonMissingSpec, "myevents has nil events spec"))
+	})
+	It("should pass for a valid events input", func() {
+		Expect(ValidateEvents(input, forwarder)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+	})
+	It("should fail when the collector is not deployed as a deployment", func() {
+		forwarder.Annotations = nil
+		Expect(ValidateEvents(input, forwarder)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "requires the collector to be deployed as a deployment"))
+	})
+	It("should fail when the forwarder collects node logs", func() {
+		forwarder.Spec.Inputs = append(forwarder.Spec.Inputs, obs.InputSpec{Name: "infra", Type: obs.InputTypeInfrastructure})
+		Expect(ValidateEvents(input, forwarder)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "requires the collector to be deployed as a deployment"))
+	})
+})
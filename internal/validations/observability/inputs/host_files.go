@@ -0,0 +1,82 @@
+package inputs
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ValidateHostFiles validates the paths of a hostFiles input are contained by the allowed host directories
+// and are not collected by another input
+func ValidateHostFiles(spec obs.InputSpec) []metav1.Condition {
+	if spec.Type != obs.InputTypeHostFiles {
+		return nil
+	}
+
+	if spec.HostFiles == nil {
+		return []metav1.Condition{
+			NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonMissingSpec, fmt.Sprintf("%s has nil hostFiles spec", spec.Name)),
+		}
+	}
+	if len(spec.HostFiles.Includes) == 0 {
+		return []metav1.Condition{
+			NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s must define at least one include path", spec.Name)),
+		}
+	}
+	for _, include := range spec.HostFiles.Includes {
+		if msg := validateHostFilesPath(include); msg != "" {
+			return []metav1.Condition{
+				NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s include path %q %s", spec.Name, include, msg)),
+			}
+		}
+	}
+	for _, exclude := range spec.HostFiles.Excludes {
+		if !path.IsAbs(exclude) {
+			return []metav1.Condition{
+				NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s exclude path %q must be absolute", spec.Name, exclude)),
+			}
+		}
+	}
+	if spec.HostFiles.MultilineStartPattern != "" {
+		if _, err := regexp.Compile(spec.HostFiles.MultilineStartPattern); err != nil {
+			return []metav1.Condition{
+				NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s has an invalid multilineStartPattern: %v", spec.Name, err)),
+			}
+		}
+	}
+	return []metav1.Condition{
+		NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("input %q is valid", spec.Name)),
+	}
+}
+
+// validateHostFilesPath returns a message describing why an include path may not be collected, if any
+func validateHostFilesPath(pattern string) string {
+	if !path.IsAbs(pattern) {
+		return "must be absolute"
+	}
+	if path.Clean(pattern) != pattern {
+		return "must be a clean path without relative elements"
+	}
+	dir := HostFilesDir(pattern)
+	allowed := false
+	for _, parent := range HostFilesAllowedDirs {
+		if dir != parent && IsSubPath(dir, parent) {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return fmt.Sprintf("must be in a subdirectory of one of %s", strings.Join(HostFilesAllowedDirs, ", "))
+	}
+	for _, reserved := range HostFilesReservedDirs {
+		if IsSubPath(dir, reserved) {
+			return fmt.Sprintf("may not be in %s which is collected by other inputs", reserved)
+		}
+	}
+	return ""
+}
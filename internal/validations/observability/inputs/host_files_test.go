@@ -0,0 +1,64 @@
+package inputs
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("#ValidateHostFiles", func() {
+
+	var (
+		input              obs.InputSpec
+		expConditionTypeRE = obs.ConditionTypeValidInputPrefix + "-.*"
+	)
+	BeforeEach(func() {
+		input = obs.InputSpec{
+			Name: "gpu",
+			Type: obs.InputTypeHostFiles,
+			HostFiles: &obs.HostFiles{
+				Includes:              []string{"/var/log/nvidia/*.log", "/var/log/storage/**/*.log"},
+				Excludes:              []string{"/var/log/nvidia/debug.log"},
+				MultilineStartPattern: `^\d{4}-`,
+			},
+		}
+	})
+	It("should skip the validation when not a hostFiles type", func() {
+		input.Type = obs.InputTypeApplication
+		Expect(ValidateHostFiles(input)).To(BeEmpty())
+	})
+	It("should fail when a hostFiles type but has no hostFiles input", func() {
+		input.HostFiles = nil
+		Expect(ValidateHostFiles(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonMissingSpec, "gpu has nil hostFiles spec"))
+	})
+	It("should pass for a valid hostFiles input", func() {
+		Expect(ValidateHostFiles(input)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+	})
+	It("should fail when there are no include paths", func() {
+		input.HostFiles.Includes = nil
+		Expect(ValidateHostFiles(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "must define at least one include path"))
+	})
+	It("should fail when an exclude path is not absolute", func() {
+		input.HostFiles.Excludes = []string{"debug.log"}
+		Expect(ValidateHostFiles(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `exclude path "debug.log" must be absolute`))
+	})
+	It("should fail when the multiline start pattern is invalid", func() {
+		input.HostFiles.MultilineStartPattern = "^(["
+		Expect(ValidateHostFiles(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "invalid multilineStartPattern"))
+	})
+
+	DescribeTable("should fail for an include path", func(include, msg string) {
+		input.HostFiles.Includes = []string{include}
+		Expect(ValidateHostFiles(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, msg))
+	},
+		Entry("which is relative", "nvidia/*.log", "must be absolute"),
+		Entry("which has relative elements", "/var/log/nvidia/../../../etc/*", "must be a clean path"),
+		Entry("which is not in an allowed directory", "/etc/nvidia/*.log", "must be in a subdirectory of one of /var/log"),
+		Entry("which is directly in an allowed directory", "/var/log/*.log", "must be in a subdirectory of one of /var/log"),
+		Entry("whose directory is a pattern", "/var/log/*/*.log", "must be in a subdirectory of one of /var/log"),
+		Entry("which is collected by the container source", "/var/log/pods/*/*.log", "may not be in /var/log/pods"),
+		Entry("which is collected by the audit source", "/var/log/audit/audit.log", "may not be in /var/log/audit"),
+	)
+})
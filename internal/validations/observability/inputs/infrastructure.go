@@ -2,11 +2,17 @@ package inputs
 
 import (
 	"fmt"
+	"regexp"
+
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	. "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/set"
 )
 
+// systemdUnitName matches the name of a systemd unit with an optional unit type suffix
+var systemdUnitName = regexp.MustCompile(`^[a-zA-Z0-9:_.@-]+$`)
+
 func ValidateInfrastructure(spec obs.InputSpec) []metav1.Condition {
 	if spec.Type != obs.InputTypeInfrastructure {
 		return nil
@@ -22,7 +28,35 @@ func ValidateInfrastructure(spec obs.InputSpec) []metav1.Condition {
 			NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s must define at least one valid source", spec.Name)),
 		}
 	}
+	if msg := validateJournal(spec.Infrastructure); msg != "" {
+		return []metav1.Condition{
+			NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s %s", spec.Name, msg)),
+		}
+	}
 	return []metav1.Condition{
 		NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("input %q is valid", spec.Name)),
 	}
 }
+
+// validateJournal returns a message describing the first invalid journal filter, if any
+func validateJournal(infra *obs.Infrastructure) string {
+	journal := infra.Journal
+	if journal == nil {
+		return ""
+	}
+	if !set.New(infra.Sources...).Has(obs.InfrastructureSourceNode) {
+		return "journal is only supported when collecting the node source"
+	}
+	for _, unit := range append(append([]obs.SystemdUnit{}, journal.IncludeUnits...), journal.ExcludeUnits...) {
+		if !systemdUnitName.MatchString(string(unit)) {
+			return fmt.Sprintf("has an invalid systemd unit name %q", unit)
+		}
+	}
+	excluded := set.New(journal.ExcludeUnits...)
+	for _, unit := range journal.IncludeUnits {
+		if excluded.Has(unit) {
+			return fmt.Sprintf("includes and excludes the systemd unit %q", unit)
+		}
+	}
+	return ""
+}
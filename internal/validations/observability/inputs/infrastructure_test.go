@@ -41,4 +41,28 @@ var _ = Describe("#ValidateInfrastructure", func() {
 		input.Infrastructure.Sources = []obs.InfrastructureSource{}
 		Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "must define at least one valid source"))
 	})
+	Context("with journal filters", func() {
+		BeforeEach(func() {
+			input.Infrastructure.Sources = []obs.InfrastructureSource{obs.InfrastructureSourceNode}
+			input.Infrastructure.Journal = &obs.Journal{
+				IncludeUnits: []obs.SystemdUnit{"kubelet.service", "crio", "getty@tty1.service"},
+				ExcludeUnits: []obs.SystemdUnit{"systemd-journald.service"},
+			}
+		})
+		It("should pass for valid journal filters", func() {
+			Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+		It("should fail when the node source is not collected", func() {
+			input.Infrastructure.Sources = []obs.InfrastructureSource{obs.InfrastructureSourceContainer}
+			Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "journal is only supported when collecting the node source"))
+		})
+		It("should fail for an invalid unit name", func() {
+			input.Infrastructure.Journal.ExcludeUnits = []obs.SystemdUnit{"bad unit"}
+			Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `invalid systemd unit name "bad unit"`))
+		})
+		It("should fail when a unit is included and excluded", func() {
+			input.Infrastructure.Journal.ExcludeUnits = []obs.SystemdUnit{"crio"}
+			Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `includes and excludes the systemd unit "crio"`))
+		})
+	})
 })
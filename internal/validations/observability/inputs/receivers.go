@@ -12,9 +12,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"os"
+	"regexp"
 	"strings"
 )
 
+// bearerTokenRegex matches the b64token syntax of a RFC 6750 bearer token
+var bearerTokenRegex = regexp.MustCompile(`^[A-Za-z0-9\-._~+/]+=*$`)
+
 // ValidateReceiver validates receiver input specs
 func ValidateReceiver(spec obs.InputSpec, secrets map[string]*corev1.Secret, configMaps map[string]*corev1.ConfigMap, context utils.Options) []metav1.Condition {
 	if secrets == nil || configMaps == nil {
@@ -40,6 +44,73 @@ func ValidateReceiver(spec obs.InputSpec, secrets map[string]*corev1.Secret, con
 			NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s does not specify a format", spec.Name)),
 		}
 	}
+	if spec.Receiver.Type == obs.ReceiverTypeHTTP {
+		if spec.Receiver.HTTP.ItemsPath != "" && spec.Receiver.HTTP.Format != obs.HTTPReceiverFormatJSON {
+			return []metav1.Condition{
+				NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s: itemsPath is only supported for the json format", spec.Name)),
+			}
+		}
+		if spec.Receiver.HTTP.Format == obs.HTTPReceiverFormatKubeAPIAudit && (spec.Receiver.HTTP.LogType != "" || spec.Receiver.HTTP.LogSource != "") {
+			return []metav1.Condition{
+				NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s: logType and logSource are not supported for the kubeAPIAudit format", spec.Name)),
+			}
+		}
+	}
+	if spec.Receiver.Syslog != nil {
+		if spec.Receiver.Type != obs.ReceiverTypeSyslog {
+			return []metav1.Condition{
+				NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s defines a syslog spec for a %s receiver", spec.Name, spec.Receiver.Type)),
+			}
+		}
+		if spec.Receiver.TLS != nil && spec.Receiver.Syslog.Protocol != "" && spec.Receiver.Syslog.Protocol != obs.SyslogReceiverProtocolTCP {
+			return []metav1.Condition{
+				NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s: TLS is only supported for the tcp protocol of the syslog receiver", spec.Name)),
+			}
+		}
+	}
+	if spec.Receiver.OTLP != nil {
+		if spec.Receiver.Type != obs.ReceiverTypeOTLP {
+			return []metav1.Condition{
+				NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s defines an otlp spec for a %s receiver", spec.Name, spec.Receiver.Type)),
+			}
+		}
+		if spec.Receiver.OTLP.GRPCPort != nil && *spec.Receiver.OTLP.GRPCPort == spec.Receiver.Port {
+			return []metav1.Condition{
+				NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s: grpcPort must be different from port", spec.Name)),
+			}
+		}
+	}
+	if spec.Receiver.Forward != nil {
+		if spec.Receiver.Type != obs.ReceiverTypeForward {
+			return []metav1.Condition{
+				NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s defines a forward spec for a %s receiver", spec.Name, spec.Receiver.Type)),
+			}
+		}
+		for i, mapping := range spec.Receiver.Forward.TagNamespaces {
+			if _, err := regexp.Compile(mapping.Tag); err != nil {
+				return []metav1.Condition{
+					NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s: tagNamespaces[%d] tag must be a valid regular expression: %v", spec.Name, i, err)),
+				}
+			}
+		}
+	}
+	if auth := spec.Receiver.Authentication; auth != nil {
+		if messages := validateReceiverAuthentication(*spec.Receiver); len(messages) > 0 {
+			return []metav1.Condition{
+				NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s: %s", spec.Name, strings.Join(messages, ","))),
+			}
+		}
+		if messages := common.ValidateValueReference(ReceiverAuthValueReferences(auth), secrets, configMaps); len(messages) > 0 {
+			return []metav1.Condition{
+				NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, strings.Join(messages, ",")),
+			}
+		}
+		if auth.Token != nil && !bearerTokenRegex.Match(secrets[auth.Token.SecretName].Data[auth.Token.Key]) {
+			return []metav1.Condition{
+				NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s: token must only contain the characters of a RFC 6750 bearer token", spec.Name)),
+			}
+		}
+	}
 	if spec.Receiver.TLS != nil {
 		tlsSpec := obs.TLSSpec(*spec.Receiver.TLS)
 		keys := ValueReferences(tlsSpec)
@@ -57,6 +128,24 @@ func ValidateReceiver(spec obs.InputSpec, secrets map[string]*corev1.Secret, con
 	}
 }
 
+// validateReceiverAuthentication validates the authentication methods spec'd are supported by the receiver
+func validateReceiverAuthentication(receiver obs.ReceiverSpec) (messages []string) {
+	auth := receiver.Authentication
+	if (auth.Token != nil || auth.Username != nil || auth.Password != nil) && receiver.Type != obs.ReceiverTypeHTTP {
+		messages = append(messages, "token and basic authentication are only supported for the http receiver type")
+	}
+	if auth.Token != nil && (auth.Username != nil || auth.Password != nil) {
+		messages = append(messages, "token and basic authentication are mutually exclusive")
+	}
+	if (auth.Username == nil) != (auth.Password == nil) {
+		messages = append(messages, "username and password must be spec'd together")
+	}
+	if auth.ClientCA != nil && receiver.Syslog != nil && receiver.Syslog.Protocol != "" && receiver.Syslog.Protocol != obs.SyslogReceiverProtocolTCP {
+		messages = append(messages, "client certificates are only supported for the tcp protocol of the syslog receiver")
+	}
+	return messages
+}
+
 func removeGeneratedSecrets(keys []*obs.ValueReference, skipKeys *set.Set) (result []*obs.ValueReference) {
 	for _, secretKey := range keys {
 		if secretKey.SecretName != "" {
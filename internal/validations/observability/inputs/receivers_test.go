@@ -62,6 +62,87 @@ var _ = Describe("#ValidateReceiver", func() {
 			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
 			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
 		})
+		It("should fail when an itemsPath is spec'd for a HTTP receiver which does not receive json", func() {
+			spec.Receiver.Type = obs.ReceiverTypeHTTP
+			spec.Receiver.HTTP = &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatNDJSON, ItemsPath: ".records"}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "itemsPath is only supported for the json format"))
+		})
+		It("should fail when a log type is spec'd for a HTTP receiver of kubernetes API audit events", func() {
+			spec.Receiver.Type = obs.ReceiverTypeHTTP
+			spec.Receiver.HTTP = &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatKubeAPIAudit, LogType: obs.InputTypeApplication}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "logType and logSource are not supported for the kubeAPIAudit format"))
+		})
+		It("should pass for a valid json HTTP receiver spec", func() {
+			spec.Receiver.Type = obs.ReceiverTypeHTTP
+			spec.Receiver.HTTP = &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatJSON, ItemsPath: ".records", LogSource: "functions"}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+		It("should fail when an otlp spec is defined for a syslog receiver", func() {
+			spec.Receiver.Type = obs.ReceiverTypeSyslog
+			spec.Receiver.OTLP = &obs.OTLPReceiver{}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "defines an otlp spec for a syslog receiver"))
+		})
+		It("should fail when the grpc port of an otlp receiver is the port of the receiver", func() {
+			spec.Receiver.Type = obs.ReceiverTypeOTLP
+			spec.Receiver.Port = 4318
+			spec.Receiver.OTLP = &obs.OTLPReceiver{GRPCPort: utils.GetPtr(int32(4318))}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "grpcPort must be different from port"))
+		})
+		It("should pass for a valid otlp receiver spec", func() {
+			spec.Receiver.Type = obs.ReceiverTypeOTLP
+			spec.Receiver.Port = 4318
+			spec.Receiver.OTLP = &obs.OTLPReceiver{GRPCPort: utils.GetPtr(int32(4317))}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+		It("should fail when a forward spec is defined for a http receiver", func() {
+			spec.Receiver.Type = obs.ReceiverTypeHTTP
+			spec.Receiver.HTTP = &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatJSON}
+			spec.Receiver.Forward = &obs.ForwardReceiver{}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "defines a forward spec for a http receiver"))
+		})
+		It("should fail when a forward receiver maps a tag which is not a valid regular expression", func() {
+			spec.Receiver.Type = obs.ReceiverTypeForward
+			spec.Receiver.Forward = &obs.ForwardReceiver{
+				TagNamespaces: []obs.ForwardTagNamespace{{Tag: "^app(", Namespace: "app"}},
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `tagNamespaces\[0\] tag must be a valid regular expression`))
+		})
+		It("should pass for a valid forward receiver spec", func() {
+			spec.Receiver.Type = obs.ReceiverTypeForward
+			spec.Receiver.Forward = &obs.ForwardReceiver{
+				TagNamespaces: []obs.ForwardTagNamespace{{Tag: `^app\.`, Namespace: "app"}},
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+		It("should pass for a valid syslog receiver spec listening for udp", func() {
+			spec.Receiver.Type = obs.ReceiverTypeSyslog
+			spec.Receiver.Syslog = &obs.SyslogReceiver{Protocol: obs.SyslogReceiverProtocolUDP}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+		It("should fail when a syslog receiver listening for udp specs TLS", func() {
+			spec.Receiver.Type = obs.ReceiverTypeSyslog
+			spec.Receiver.Syslog = &obs.SyslogReceiver{Protocol: obs.SyslogReceiverProtocolBoth}
+			spec.Receiver.TLS = &obs.InputTLSSpec{}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "TLS is only supported for the tcp protocol"))
+		})
+		It("should fail when a syslog spec is defined for a HTTP receiver", func() {
+			spec.Receiver.Type = obs.ReceiverTypeHTTP
+			spec.Receiver.HTTP = &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatKubeAPIAudit}
+			spec.Receiver.Syslog = &obs.SyslogReceiver{}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "defines a syslog spec for a http receiver"))
+		})
 		It("should fail validate secrets if spec'd", func() {
 			spec.Receiver.Type = obs.ReceiverTypeSyslog
 			spec.Receiver.TLS = &obs.InputTLSSpec{
@@ -73,6 +154,67 @@ var _ = Describe("#ValidateReceiver", func() {
 			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
 			Expect(conds).To(Not(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, "")))
 		})
+		Context("with authentication", func() {
+			var (
+				authSecrets = map[string]*corev1.Secret{
+					"receiver-auth": runtime.NewSecret("", "receiver-auth", map[string][]byte{
+						"token":         []byte("abc"),
+						"invalid-token": []byte(`a"b\c`),
+						"username":      []byte("user"),
+						"password":      []byte("pass"),
+					}),
+				}
+				secretRef = func(key string) *obs.SecretReference {
+					return &obs.SecretReference{Key: key, SecretName: "receiver-auth"}
+				}
+			)
+			BeforeEach(func() {
+				spec.Receiver.Type = obs.ReceiverTypeHTTP
+				spec.Receiver.HTTP = &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatJSON}
+			})
+			It("should pass for a HTTP receiver which authenticates with a token", func() {
+				spec.Receiver.Authentication = &obs.ReceiverAuthentication{Token: secretRef("token")}
+				conds := ValidateReceiver(spec, authSecrets, configMaps, utils.NoOptions)
+				Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+			})
+			It("should fail when the secret of the token does not exist", func() {
+				spec.Receiver.Authentication = &obs.ReceiverAuthentication{Token: secretRef("token")}
+				conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+				Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "receiver-auth"))
+			})
+			It("should fail when the token contains characters which are not allowed in a bearer token", func() {
+				spec.Receiver.Authentication = &obs.ReceiverAuthentication{Token: secretRef("invalid-token")}
+				conds := ValidateReceiver(spec, authSecrets, configMaps, utils.NoOptions)
+				Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "token must only contain the characters of a RFC 6750 bearer token"))
+			})
+			It("should fail when token and basic authentication are spec'd", func() {
+				spec.Receiver.Authentication = &obs.ReceiverAuthentication{Token: secretRef("token"), Username: secretRef("username"), Password: secretRef("password")}
+				conds := ValidateReceiver(spec, authSecrets, configMaps, utils.NoOptions)
+				Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "token and basic authentication are mutually exclusive"))
+			})
+			It("should fail when a username is spec'd without a password", func() {
+				spec.Receiver.Authentication = &obs.ReceiverAuthentication{Username: secretRef("username")}
+				conds := ValidateReceiver(spec, authSecrets, configMaps, utils.NoOptions)
+				Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "username and password must be spec'd together"))
+			})
+			It("should fail when a syslog receiver authenticates with a token", func() {
+				spec.Receiver.Type = obs.ReceiverTypeSyslog
+				spec.Receiver.HTTP = nil
+				spec.Receiver.Authentication = &obs.ReceiverAuthentication{Token: secretRef("token")}
+				conds := ValidateReceiver(spec, authSecrets, configMaps, utils.NoOptions)
+				Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "only supported for the http receiver type"))
+			})
+			It("should fail when a syslog receiver listening for udp verifies client certificates", func() {
+				spec.Receiver.Type = obs.ReceiverTypeSyslog
+				spec.Receiver.HTTP = nil
+				spec.Receiver.Syslog = &obs.SyslogReceiver{Protocol: obs.SyslogReceiverProtocolUDP}
+				spec.Receiver.Authentication = &obs.ReceiverAuthentication{
+					ClientCA: &obs.ValueReference{Key: "ca-bundle.crt", ConfigMapName: "client-ca"},
+				}
+				conds := ValidateReceiver(spec, authSecrets, configMaps, utils.NoOptions)
+				Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "client certificates are only supported for the tcp protocol"))
+			})
+		})
 		Context("for secrets provied by the cert signing service", func() {
 			It("should skip validation", func() {
 
@@ -20,6 +20,10 @@ func Validate(context internalcontext.ForwarderContext) {
 			conditions = ValidateAudit(i)
 		case obs.InputTypeReceiver:
 			conditions = ValidateReceiver(i, context.Secrets, context.ConfigMaps, context.AdditionalContext)
+		case obs.InputTypeKubernetesEvents:
+			conditions = ValidateEvents(i, *context.Forwarder)
+		case obs.InputTypeHostFiles:
+			conditions = ValidateHostFiles(i)
 		}
 		results = append(results, conditions...)
 	}
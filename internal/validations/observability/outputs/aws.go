@@ -11,15 +11,17 @@ import (
 
 const (
 	RoleARNsOpt           = "roleARNs"
-	ErrVariousRoleARNAuth = "Found multiple different CloudWatch RoleARN authorizations in the outputs spec"
+	ErrVariousRoleARNAuth = "Found multiple different AWS RoleARN authorizations in the outputs spec"
 )
 
-func ValidateCloudWatchAuth(spec obs.OutputSpec, context internalcontext.ForwarderContext) (results []string) {
+// ValidateAWSAuth verifies the role ARN is the same for all outputs authenticating to AWS by IAM role because
+// the collector can only be configured with a single web identity
+func ValidateAWSAuth(spec obs.OutputSpec, context internalcontext.ForwarderContext) (results []string) {
+	authSpec, _ := observability.AWSAuthentication(spec)
 	secrets := observability.Secrets(context.Secrets)
 	additionalContext := context.AdditionalContext
-	authSpec := spec.Cloudwatch.Authentication
 
-	if authSpec.Type == obs.CloudwatchAuthTypeIAMRole {
+	if authSpec != nil && authSpec.Type == obs.CloudwatchAuthTypeIAMRole {
 		roleArn := cloudwatch.ParseRoleArn(authSpec, secrets)
 		roleARNs := set.New(roleArn)
 		utils.Update(additionalContext, RoleARNsOpt, roleARNs, func(existing *set.Set) *set.Set {
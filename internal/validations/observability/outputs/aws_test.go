@@ -0,0 +1,165 @@
+package outputs
+
+import (
+	"github.com/golang-collections/collections/set"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("validating AWS auth", func() {
+	Context("#ValidateAWSAuth", func() {
+
+		var (
+			myRoleArn    = "arn:aws:iam::123456789012:role/my-role-to-assume"
+			otherRoleArn = "arn:aws:iam::123456789012:role/other-role-to-assume"
+			spec         = obs.OutputSpec{
+				Name: "output",
+				Type: obs.OutputTypeS3,
+				S3: &obs.S3{
+					Bucket: "my-archive",
+					Authentication: &obs.CloudwatchAuthentication{
+						Type: obs.CloudwatchAuthTypeIAMRole,
+						IAMRole: &obs.CloudwatchIAMRole{
+							RoleARN: obs.SecretReference{
+								SecretName: "foo",
+								Key:        constants.AWSCredentialsKey,
+							},
+							Token: obs.BearerToken{
+								From: obs.BearerTokenFromServiceAccount,
+							},
+						},
+					},
+				},
+			}
+
+			fooSecret = &corev1.Secret{
+				ObjectMeta: v1.ObjectMeta{
+					Name: "foo",
+				},
+				Data: map[string][]byte{
+					constants.AWSCredentialsKey: []byte(myRoleArn),
+				},
+			}
+			context = internalcontext.ForwarderContext{
+				Forwarder: &obs.ClusterLogForwarder{
+					Spec: obs.ClusterLogForwarderSpec{
+						Outputs: []obs.OutputSpec{spec},
+					},
+				},
+				Secrets: map[string]*corev1.Secret{
+					fooSecret.Name: fooSecret,
+				},
+			}
+		)
+
+		It("should fail validation if the Role ARN differs from the one of another output", func() {
+			context.AdditionalContext = utils.Options{
+				RoleARNsOpt: set.New(otherRoleArn),
+			}
+			res := ValidateAWSAuth(spec, context)
+			Expect(res).To(ConsistOf(ErrVariousRoleARNAuth))
+		})
+
+		It("should pass validation if Role ARNs are equal", func() {
+			context.AdditionalContext = utils.Options{
+				RoleARNsOpt: set.New(myRoleArn),
+			}
+			Expect(ValidateAWSAuth(spec, context)).To(BeEmpty())
+		})
+
+		It("should pass validation when authenticating with access keys", func() {
+			context.AdditionalContext = utils.Options{
+				RoleARNsOpt: set.New(otherRoleArn),
+			}
+			keySpec := *spec.DeepCopy()
+			keySpec.S3.Authentication = &obs.CloudwatchAuthentication{
+				Type: obs.CloudwatchAuthTypeAccessKey,
+				AWSAccessKey: &obs.CloudwatchAWSAccessKey{
+					KeyId:     obs.SecretReference{SecretName: "foo", Key: constants.AWSAccessKeyID},
+					KeySecret: obs.SecretReference{SecretName: "foo", Key: constants.AWSSecretAccessKey},
+				},
+			}
+			Expect(ValidateAWSAuth(keySpec, context)).To(BeEmpty())
+		})
+
+		It("should fail validation if the Role ARN of a cloudwatch output differs from the one of another output", func() {
+			context.AdditionalContext = utils.Options{
+				RoleARNsOpt: set.New(otherRoleArn),
+			}
+			cwSpec := obs.OutputSpec{
+				Name: "cw",
+				Type: obs.OutputTypeCloudwatch,
+				Cloudwatch: &obs.Cloudwatch{
+					Authentication: spec.S3.Authentication,
+				},
+			}
+			Expect(ValidateAWSAuth(cwSpec, context)).To(ConsistOf(ErrVariousRoleARNAuth))
+		})
+
+		It("should pass validation if the Role ARN of a cloudwatch output equals the one of another output", func() {
+			context.AdditionalContext = utils.Options{
+				RoleARNsOpt: set.New(myRoleArn),
+			}
+			cwSpec := obs.OutputSpec{
+				Name: "cw",
+				Type: obs.OutputTypeCloudwatch,
+				Cloudwatch: &obs.Cloudwatch{
+					Authentication: spec.S3.Authentication,
+				},
+			}
+			Expect(ValidateAWSAuth(cwSpec, context)).To(BeEmpty())
+		})
+
+		It("should fail validation if the Role ARN of an elasticsearch output differs from the one of another output", func() {
+			context.AdditionalContext = utils.Options{
+				RoleARNsOpt: set.New(otherRoleArn),
+			}
+			esSpec := obs.OutputSpec{
+				Name: "es",
+				Type: obs.OutputTypeElasticsearch,
+				Elasticsearch: &obs.Elasticsearch{
+					Index: "logs",
+					Authentication: &obs.ElasticsearchAuthentication{
+						AWS: &obs.AWSAuthentication{
+							CloudwatchAuthentication: *spec.S3.Authentication,
+							Region:                   "us-east-1",
+						},
+					},
+				},
+			}
+			Expect(ValidateAWSAuth(esSpec, context)).To(ConsistOf(ErrVariousRoleARNAuth))
+		})
+
+		It("should fail validation if the Role ARN of an http output differs from the one of another output", func() {
+			context.AdditionalContext = utils.Options{
+				RoleARNsOpt: set.New(otherRoleArn),
+			}
+			httpSpec := obs.OutputSpec{
+				Name: "http",
+				Type: obs.OutputTypeHTTP,
+				HTTP: &obs.HTTP{
+					Authentication: &obs.HTTPOutputAuthentication{
+						AWS: &obs.AWSAuthentication{
+							CloudwatchAuthentication: *spec.S3.Authentication,
+							Region:                   "us-east-1",
+						},
+					},
+				},
+			}
+			Expect(ValidateAWSAuth(httpSpec, context)).To(ConsistOf(ErrVariousRoleARNAuth))
+		})
+
+		It("should pass validation for outputs not authenticating to AWS", func() {
+			context.AdditionalContext = utils.Options{
+				RoleARNsOpt: set.New(otherRoleArn),
+			}
+			Expect(ValidateAWSAuth(obs.OutputSpec{Name: "http", Type: obs.OutputTypeHTTP, HTTP: &obs.HTTP{}}, context)).To(BeEmpty())
+		})
+	})
+})
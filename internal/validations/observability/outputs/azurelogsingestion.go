@@ -0,0 +1,55 @@
+package outputs
+
+import (
+	"fmt"
+
+	"github.com/golang-collections/collections/set"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+const (
+	AzureAuthorityHostsOpt         = "azureAuthorityHosts"
+	AzureFederatedTokensOpt        = "azureFederatedTokens"
+	ErrVariousAzureAuthorityHosts  = "Found multiple different Azure Logs Ingestion authority hosts in the outputs spec"
+	ErrVariousAzureFederatedTokens = "Found multiple different Azure Logs Ingestion workload identity tokens in the outputs spec"
+)
+
+// ValidateAzureLogsIngestionAuth verifies the authority host and the workload identity token are the same for all
+// Azure Logs Ingestion outputs because the collector can only be configured with a single Entra ID environment
+func ValidateAzureLogsIngestionAuth(spec obs.OutputSpec, context internalcontext.ForwarderContext) (results []string) {
+	if spec.AzureLogsIngestion == nil || spec.AzureLogsIngestion.Authentication == nil {
+		return results
+	}
+	auth := spec.AzureLogsIngestion.Authentication
+	additionalContext := context.AdditionalContext
+
+	hosts := set.New(auth.AuthorityHost)
+	utils.Update(additionalContext, AzureAuthorityHostsOpt, hosts, func(existing *set.Set) *set.Set {
+		existing = existing.Union(hosts)
+		if existing.Len() > 1 {
+			results = append(results, ErrVariousAzureAuthorityHosts)
+		}
+		return existing
+	})
+
+	if auth.Type == obs.AzureLogsIngestionAuthTypeWorkloadIdentity && auth.Token != nil {
+		tokens := set.New(federatedTokenSource(auth.Token))
+		utils.Update(additionalContext, AzureFederatedTokensOpt, tokens, func(existing *set.Set) *set.Set {
+			existing = existing.Union(tokens)
+			if existing.Len() > 1 {
+				results = append(results, ErrVariousAzureFederatedTokens)
+			}
+			return existing
+		})
+	}
+	return results
+}
+
+func federatedTokenSource(token *obs.BearerToken) string {
+	if token.From == obs.BearerTokenFromSecret && token.Secret != nil {
+		return fmt.Sprintf("%s/%s", token.Secret.Name, token.Secret.Key)
+	}
+	return string(token.From)
+}
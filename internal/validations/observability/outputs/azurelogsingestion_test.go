@@ -0,0 +1,79 @@
+package outputs
+
+import (
+	"github.com/golang-collections/collections/set"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+var _ = Describe("validating Azure Logs Ingestion auth", func() {
+	Context("#ValidateAzureLogsIngestionAuth", func() {
+
+		var (
+			usGovHost = "https://login.microsoftonline.us"
+			spec      = obs.OutputSpec{
+				Name: "output",
+				Type: obs.OutputTypeAzureLogsIngestion,
+				AzureLogsIngestion: &obs.AzureLogsIngestion{
+					DCRImmutableID: "dcr-00000000000000000000000000000000",
+					StreamName:     "Custom-MyTable_CL",
+					Authentication: &obs.AzureLogsIngestionAuthentication{
+						Type:          obs.AzureLogsIngestionAuthTypeWorkloadIdentity,
+						TenantID:      obs.SecretReference{SecretName: "foo", Key: "tenant_id"},
+						ClientID:      obs.SecretReference{SecretName: "foo", Key: "client_id"},
+						AuthorityHost: usGovHost,
+						Token: &obs.BearerToken{
+							From: obs.BearerTokenFromServiceAccount,
+						},
+					},
+				},
+			}
+			context = internalcontext.ForwarderContext{
+				Forwarder: &obs.ClusterLogForwarder{
+					Spec: obs.ClusterLogForwarderSpec{
+						Outputs: []obs.OutputSpec{spec},
+					},
+				},
+			}
+		)
+
+		It("should pass validation for the first output", func() {
+			context.AdditionalContext = utils.Options{}
+			Expect(ValidateAzureLogsIngestionAuth(spec, context)).To(BeEmpty())
+		})
+
+		It("should pass validation if the authority hosts and tokens are equal", func() {
+			context.AdditionalContext = utils.Options{
+				AzureAuthorityHostsOpt:  set.New(usGovHost),
+				AzureFederatedTokensOpt: set.New(string(obs.BearerTokenFromServiceAccount)),
+			}
+			Expect(ValidateAzureLogsIngestionAuth(spec, context)).To(BeEmpty())
+		})
+
+		It("should fail validation if the authority host differs from the one of another output", func() {
+			context.AdditionalContext = utils.Options{
+				AzureAuthorityHostsOpt: set.New(""),
+			}
+			Expect(ValidateAzureLogsIngestionAuth(spec, context)).To(ConsistOf(ErrVariousAzureAuthorityHosts))
+		})
+
+		It("should fail validation if the token differs from the one of another output", func() {
+			context.AdditionalContext = utils.Options{
+				AzureFederatedTokensOpt: set.New(string(obs.BearerTokenFromServiceAccount)),
+			}
+			secretSpec := *spec.DeepCopy()
+			secretSpec.AzureLogsIngestion.Authentication.Token = &obs.BearerToken{
+				From: obs.BearerTokenFromSecret,
+				Secret: &obs.BearerTokenSecretKey{
+					Name: "foo",
+					Key:  constants.TokenKey,
+				},
+			}
+			Expect(ValidateAzureLogsIngestionAuth(secretSpec, context)).To(ConsistOf(ErrVariousAzureFederatedTokens))
+		})
+	})
+})
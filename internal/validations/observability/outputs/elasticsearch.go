@@ -0,0 +1,29 @@
+package outputs
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	ErrDataStreamVersion = "dataStream requires Elasticsearch version 7 or later"
+	ErrAWSServiceName    = "aws serviceName must be one of: es, aoss"
+)
+
+// awsServiceNames are the AWS signing names of the services which receive Elasticsearch requests
+var awsServiceNames = sets.NewString("", "es", "aoss")
+
+// validateElasticsearch verifies the Elasticsearch version supports the features of the output and the AWS service
+// is Amazon OpenSearch Service or Amazon OpenSearch Serverless
+func validateElasticsearch(output obs.OutputSpec) (results []string) {
+	if output.Elasticsearch == nil {
+		return results
+	}
+	if output.Elasticsearch.DataStream != nil && output.Elasticsearch.Version < 7 {
+		results = append(results, ErrDataStreamVersion)
+	}
+	if a := output.Elasticsearch.Authentication; a != nil && a.AWS != nil && !awsServiceNames.Has(a.AWS.ServiceName) {
+		results = append(results, ErrAWSServiceName)
+	}
+	return results
+}
@@ -0,0 +1,60 @@
+package outputs
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("validating Elasticsearch outputs", func() {
+	Context("#validateElasticsearch", func() {
+		var (
+			spec obs.OutputSpec
+		)
+		BeforeEach(func() {
+			spec = obs.OutputSpec{
+				Name: "es",
+				Type: obs.OutputTypeElasticsearch,
+				Elasticsearch: &obs.Elasticsearch{
+					DataStream: &obs.ElasticsearchDataStream{
+						Dataset: "myapp",
+					},
+					Version: 8,
+				},
+			}
+		})
+
+		It("should pass validation for data streams with Elasticsearch 8", func() {
+			Expect(validateElasticsearch(spec)).To(BeEmpty())
+		})
+
+		It("should fail validation for data streams with Elasticsearch 6", func() {
+			spec.Elasticsearch.Version = 6
+			Expect(validateElasticsearch(spec)).To(ConsistOf(ErrDataStreamVersion))
+		})
+
+		It("should pass validation for an index with Elasticsearch 6", func() {
+			spec.Elasticsearch.Version = 6
+			spec.Elasticsearch.DataStream = nil
+			spec.Elasticsearch.Index = "app-write"
+			Expect(validateElasticsearch(spec)).To(BeEmpty())
+		})
+
+		DescribeTable("AWS service name", func(serviceName string, expected types.GomegaMatcher) {
+			spec.Elasticsearch.Authentication = &obs.ElasticsearchAuthentication{
+				AWS: &obs.AWSAuthentication{
+					Region:      "us-east-1",
+					ServiceName: serviceName,
+				},
+			}
+			Expect(validateElasticsearch(spec)).To(expected)
+		},
+			Entry("should pass validation for the default service", "", BeEmpty()),
+			Entry("should pass validation for Amazon OpenSearch Service", "es", BeEmpty()),
+			Entry("should pass validation for Amazon OpenSearch Serverless", "aoss", BeEmpty()),
+			Entry("should fail validation for other services", "logs", ConsistOf(ErrAWSServiceName)),
+		)
+	})
+})
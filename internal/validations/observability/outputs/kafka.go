@@ -0,0 +1,61 @@
+package outputs
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/common"
+)
+
+var (
+	// headerNameRegex matches the characters allowed in the name of a message header which is embedded in a VRL path
+	headerNameRegex = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+	// librdkafkaOptionNameRegex matches the names of librdkafka options which are embedded in the TOML configuration
+	librdkafkaOptionNameRegex = regexp.MustCompile(`^[a-z0-9_.]+$`)
+
+	// managedLibrdkafkaOptionPrefixes are the librdkafka options configured from the brokers, authentication and TLS
+	// of the output
+	managedLibrdkafkaOptionPrefixes = []string{"bootstrap.servers", "security.protocol", "sasl.", "ssl.", "enable.ssl.certificate.verification"}
+)
+
+// validateKafka verifies the message headers have valid names and templates and the librdkafka options have valid
+// names and do not override the ones managed by the operator
+func validateKafka(output obs.OutputSpec) (results []string) {
+	if output.Kafka == nil {
+		return results
+	}
+	for _, name := range sortedKeys(output.Kafka.Headers) {
+		if !headerNameRegex.MatchString(name) {
+			results = append(results, fmt.Sprintf("header %q must only contain alphanumeric characters and _.-", name))
+		}
+		if !common.TemplateRegex.MatchString(output.Kafka.Headers[name]) {
+			results = append(results, fmt.Sprintf("header %q has an invalid template: %q", name, output.Kafka.Headers[name]))
+		}
+	}
+	for _, name := range sortedKeys(output.Kafka.LibrdkafkaOptions) {
+		if !librdkafkaOptionNameRegex.MatchString(name) {
+			results = append(results, fmt.Sprintf("librdkafka option %q must only contain lowercase alphanumeric characters and _.", name))
+			continue
+		}
+		for _, prefix := range managedLibrdkafkaOptionPrefixes {
+			if strings.HasPrefix(name, prefix) {
+				results = append(results, fmt.Sprintf("librdkafka option %q is managed by the output and cannot be specified", name))
+				break
+			}
+		}
+	}
+	return results
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
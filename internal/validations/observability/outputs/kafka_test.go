@@ -0,0 +1,67 @@
+package outputs
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("validating Kafka outputs", func() {
+	Context("#validateKafka", func() {
+		var (
+			spec obs.OutputSpec
+		)
+		BeforeEach(func() {
+			spec = obs.OutputSpec{
+				Name: "kafka",
+				Type: obs.OutputTypeKafka,
+				Kafka: &obs.Kafka{
+					URL: "tls://broker:9093/topic",
+					Headers: map[string]string{
+						"cluster_id": `{.openshift.cluster_id||"none"}`,
+						"source":     "openshift",
+					},
+					LibrdkafkaOptions: map[string]string{
+						"acks":               "all",
+						"enable.idempotence": "true",
+					},
+				},
+			}
+		})
+
+		It("should pass validation for static and dynamic headers and unmanaged librdkafka options", func() {
+			Expect(validateKafka(spec)).To(BeEmpty())
+		})
+
+		It("should fail validation for a header with an invalid template", func() {
+			spec.Kafka.Headers["log_type"] = "{.log_type}"
+			Expect(validateKafka(spec)).To(ConsistOf(`header "log_type" has an invalid template: "{.log_type}"`))
+		})
+
+		It("should fail validation for a header name which can not be embedded in VRL", func() {
+			spec.Kafka.Headers[`log"type`] = "openshift"
+			Expect(validateKafka(spec)).To(ConsistOf(`header "log\"type" must only contain alphanumeric characters and _.-`))
+		})
+
+		It("should fail validation for a librdkafka option name which can not be embedded in TOML", func() {
+			spec.Kafka.LibrdkafkaOptions["acks\n[sinks]"] = "all"
+			Expect(validateKafka(spec)).To(ConsistOf(`librdkafka option "acks\n[sinks]" must only contain lowercase alphanumeric characters and _.`))
+		})
+
+		It("should fail validation for librdkafka options managed by the output", func() {
+			spec.Kafka.LibrdkafkaOptions["sasl.mechanism"] = "PLAIN"
+			spec.Kafka.LibrdkafkaOptions["ssl.ca.location"] = "/tmp/ca.crt"
+			Expect(validateKafka(spec)).To(ConsistOf(
+				`librdkafka option "sasl.mechanism" is managed by the output and cannot be specified`,
+				`librdkafka option "ssl.ca.location" is managed by the output and cannot be specified`,
+			))
+		})
+
+		It("should fail validation for disabling the verification of the broker certificates", func() {
+			spec.Kafka.LibrdkafkaOptions["enable.ssl.certificate.verification"] = "false"
+			Expect(validateKafka(spec)).To(ConsistOf(
+				`librdkafka option "enable.ssl.certificate.verification" is managed by the output and cannot be specified`,
+			))
+		})
+	})
+})
@@ -18,12 +18,17 @@ func Validate(context internalcontext.ForwarderContext) {
 			configs = append(configs, internalobs.ValueReferences(out.TLS.TLSSpec)...)
 		}
 		messages = append(messages, common.ValidateValueReference(configs, context.Secrets, context.ConfigMaps)...)
+		messages = append(messages, ValidateAWSAuth(out, context)...)
 		// Validate by output type
 		switch out.Type {
-		case obs.OutputTypeCloudwatch:
-			messages = append(messages, ValidateCloudWatchAuth(out, context)...)
+		case obs.OutputTypeAzureLogsIngestion:
+			messages = append(messages, ValidateAzureLogsIngestionAuth(out, context)...)
+		case obs.OutputTypeElasticsearch:
+			messages = append(messages, validateElasticsearch(out)...)
 		case obs.OutputTypeHTTP:
 			messages = append(messages, validateHttpContentTypeHeaders(out)...)
+		case obs.OutputTypeKafka:
+			messages = append(messages, validateKafka(out)...)
 		case obs.OutputTypeOTLP:
 			messages = append(messages, ValidateOtlpAnnotation(context)...)
 		}
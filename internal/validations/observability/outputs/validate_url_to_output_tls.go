@@ -12,6 +12,8 @@ import (
 func validateURLAccordingToTLS(output obs.OutputSpec) (results []string) {
 	specURL := ""
 	switch output.Type {
+	case obs.OutputTypeAzureLogsIngestion:
+		specURL = output.AzureLogsIngestion.URL
 	case obs.OutputTypeCloudwatch:
 		specURL = output.Cloudwatch.URL
 	case obs.OutputTypeElasticsearch:
@@ -22,6 +24,8 @@ func validateURLAccordingToTLS(output obs.OutputSpec) (results []string) {
 		specURL = output.Kafka.URL
 	case obs.OutputTypeLoki:
 		specURL = output.Loki.URL
+	case obs.OutputTypeS3:
+		specURL = output.S3.URL
 	case obs.OutputTypeSplunk:
 		specURL = output.Splunk.URL
 	case obs.OutputTypeSyslog:
@@ -19,6 +19,7 @@ func Validate(context internalcontext.ForwarderContext) {
 			messages = append(messages, fmt.Sprintf("refs not found: %s", strings.Join(refMessages, ",")))
 		}
 		messages = append(messages, verifyHostNameNotFilteredForGCL(pipelineSpec, outputs, filters)...)
+		messages = append(messages, validateRoutes(pipelineSpec)...)
 		if len(messages) > 0 {
 			internalobs.SetCondition(&context.Forwarder.Status.PipelineConditions,
 				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidPipelinePrefix, pipelineSpec.Name, false, obs.ReasonValidationFailure, strings.Join(messages, ",")))
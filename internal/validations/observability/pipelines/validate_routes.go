@@ -0,0 +1,43 @@
+package pipelines
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/filters"
+	"k8s.io/utils/set"
+)
+
+// validateRoutes validates the tests of each route and that routes only reference outputs of the pipeline
+// which in turn are all referenced by at least one route or the default route
+func validateRoutes(pipeline obs.PipelineSpec) (results []string) {
+	if len(pipeline.Routes) == 0 {
+		if len(pipeline.DefaultOutputRefs) > 0 {
+			results = append(results, "defaultOutputRefs requires routes to be defined")
+		}
+		return results
+	}
+	pipelineOutputs := set.New(pipeline.OutputRefs...)
+	routedOutputs := set.New(pipeline.DefaultOutputRefs...)
+	for _, route := range pipeline.Routes {
+		if len(route.Tests) == 0 {
+			results = append(results, fmt.Sprintf("route %q must have at least one test spec'd", route.Name))
+		}
+		for i, test := range route.Tests {
+			if testErrors := filters.ValidateDropTest(test); len(testErrors) != 0 {
+				results = append(results, fmt.Sprintf("route %q: test[%d] %v", route.Name, i, testErrors))
+			}
+		}
+		if unknown := set.New(route.OutputRefs...).Difference(pipelineOutputs); unknown.Len() > 0 {
+			results = append(results, fmt.Sprintf("route %q references outputs not in outputRefs: %v", route.Name, unknown.SortedList()))
+		}
+		routedOutputs.Insert(route.OutputRefs...)
+	}
+	if unknown := set.New(pipeline.DefaultOutputRefs...).Difference(pipelineOutputs); unknown.Len() > 0 {
+		results = append(results, fmt.Sprintf("defaultOutputRefs references outputs not in outputRefs: %v", unknown.SortedList()))
+	}
+	if unrouted := pipelineOutputs.Difference(routedOutputs); unrouted.Len() > 0 {
+		results = append(results, fmt.Sprintf("outputs not referenced by any route: %v", unrouted.SortedList()))
+	}
+	return results
+}
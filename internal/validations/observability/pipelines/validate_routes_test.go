@@ -0,0 +1,74 @@
+package pipelines
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("Pipeline validation #validateRoutes", func() {
+
+	var (
+		initSpec = func() obs.PipelineSpec {
+			return obs.PipelineSpec{
+				Name:       "myPipeline",
+				InputRefs:  []string{"anInput"},
+				OutputRefs: []string{"errors-out", "other-out"},
+				Routes: []obs.RouteSpec{
+					{
+						Name: "errors",
+						Tests: []obs.DropTest{
+							{
+								DropConditions: []obs.DropCondition{
+									{Field: ".level", Matches: "error|critical"},
+								},
+							},
+						},
+						OutputRefs: []string{"errors-out"},
+					},
+				},
+				DefaultOutputRefs: []string{"other-out"},
+			}
+		}
+	)
+
+	It("should pass validation when routes reference the outputs of the pipeline", func() {
+		Expect(validateRoutes(initSpec())).To(BeEmpty())
+	})
+
+	It("should pass validation when routes are not spec'd", func() {
+		spec := initSpec()
+		spec.Routes = nil
+		spec.DefaultOutputRefs = nil
+		Expect(validateRoutes(spec)).To(BeEmpty())
+	})
+
+	DescribeTable("should fail", func(visit func(spec *obs.PipelineSpec), messageRE string) {
+		spec := initSpec()
+		visit(&spec)
+		Expect(validateRoutes(spec)).To(ContainElement(MatchRegexp(messageRE)))
+	},
+		Entry("when default outputs are spec'd without routes", func(spec *obs.PipelineSpec) {
+			spec.Routes = nil
+		}, `^defaultOutputRefs requires routes to be defined$`),
+		Entry("when a route has no tests", func(spec *obs.PipelineSpec) {
+			spec.Routes[0].Tests = nil
+		}, `route "errors" must have at least one test`),
+		Entry("when a route test has an invalid field path", func(spec *obs.PipelineSpec) {
+			spec.Routes[0].Tests[0].DropConditions[0].Field = "level"
+		}, `route "errors": test\[0\] .*must start with a '.'`),
+		Entry("when a route test has an invalid regular expression", func(spec *obs.PipelineSpec) {
+			spec.Routes[0].Tests[0].DropConditions[0].Matches = "error("
+		}, `route "errors": test\[0\] .*must be a valid regular expression`),
+		Entry("when a route references an output not in the pipeline", func(spec *obs.PipelineSpec) {
+			spec.Routes[0].OutputRefs = append(spec.Routes[0].OutputRefs, "missing")
+		}, `route "errors" references outputs not in outputRefs: \[missing\]`),
+		Entry("when the default outputs reference an output not in the pipeline", func(spec *obs.PipelineSpec) {
+			spec.DefaultOutputRefs = []string{"missing"}
+		}, `defaultOutputRefs references outputs not in outputRefs: \[missing\]`),
+		Entry("when an output of the pipeline is not referenced by a route", func(spec *obs.PipelineSpec) {
+			spec.DefaultOutputRefs = nil
+		}, `outputs not referenced by any route: \[other-out\]`),
+	)
+})
@@ -135,7 +135,7 @@ func gatherPipelineInputs(clf obs.ClusterLogForwarder) (sets.String, bool) {
 						}
 					}
 				}
-			case obs.InputTypeInfrastructure:
+			case obs.InputTypeInfrastructure, obs.InputTypeHostFiles:
 				inputTypes.Insert(string(obs.InputTypeInfrastructure))
 			case obs.InputTypeAudit:
 				inputTypes.Insert(string(obs.InputTypeAudit))
@@ -144,6 +144,19 @@ func gatherPipelineInputs(clf obs.ClusterLogForwarder) (sets.String, bool) {
 				if input.Receiver.Type == obs.ReceiverTypeSyslog {
 					inputTypes.Insert(string(obs.InputTypeInfrastructure))
 				}
+				if input.Receiver.Type == obs.ReceiverTypeOTLP || input.Receiver.Type == obs.ReceiverTypeForward {
+					inputTypes.Insert(string(obs.InputTypeApplication))
+				}
+				if input.Receiver.Type == obs.ReceiverTypeHTTP && input.Receiver.HTTP != nil && input.Receiver.HTTP.Format != obs.HTTPReceiverFormatKubeAPIAudit {
+					logType, _ := internalobs.HTTPReceiverLogTypeAndSource(*input.Receiver.HTTP)
+					inputTypes.Insert(string(logType))
+				}
+			case obs.InputTypeKubernetesEvents:
+				if input.Events != nil {
+					for _, logType := range internalobs.KubernetesEventsLogTypes(*input.Events) {
+						inputTypes.Insert(string(logType))
+					}
+				}
 			}
 		}
 	}
@@ -239,6 +239,159 @@ var _ = Describe("[internal][validations] validate clusterlogforwarder permissio
 			Expect(customClf.Status.Conditions).To(HaveCondition(obs.ConditionTypeAuthorized, true, obs.ReasonClusterRolesExist, ""))
 		})
 
+		It("should pass validation if service account can collect application logs and there is a json HTTP receiver", func() {
+			k8sAppClient := &mockAppSARClient{
+				fake.NewFakeClient(clfServiceAccount),
+			}
+
+			const httpInputName = `http-receiver`
+			customClf.Spec = obs.ClusterLogForwarderSpec{
+				ServiceAccount: obs.ServiceAccount{
+					Name: clfServiceAccount.Name,
+				},
+				Inputs: []obs.InputSpec{
+					{
+						Name: httpInputName,
+						Type: obs.InputTypeReceiver,
+						Receiver: &obs.ReceiverSpec{
+							Type: obs.ReceiverTypeHTTP,
+							Port: 8080,
+							HTTP: &obs.HTTPReceiver{
+								Format: obs.HTTPReceiverFormatJSON,
+							},
+						},
+					},
+				},
+
+				Pipelines: []obs.PipelineSpec{
+					{
+						Name: "pipeline1",
+						InputRefs: []string{
+							httpInputName,
+						},
+					},
+				},
+			}
+			ValidatePermissions(internalcontext.ForwarderContext{
+				Client:    k8sAppClient,
+				Reader:    k8sAppClient,
+				Forwarder: &customClf,
+			})
+			Expect(customClf.Status.Conditions).To(HaveCondition(obs.ConditionTypeAuthorized, true, obs.ReasonClusterRolesExist, ""))
+		})
+
+		It("should fail validation if service account can only collect application logs and a HTTP receiver receives infrastructure logs", func() {
+			k8sAppClient := &mockAppSARClient{
+				fake.NewFakeClient(clfServiceAccount),
+			}
+
+			const httpInputName = `http-receiver`
+			customClf.Spec = obs.ClusterLogForwarderSpec{
+				ServiceAccount: obs.ServiceAccount{
+					Name: clfServiceAccount.Name,
+				},
+				Inputs: []obs.InputSpec{
+					{
+						Name: httpInputName,
+						Type: obs.InputTypeReceiver,
+						Receiver: &obs.ReceiverSpec{
+							Type: obs.ReceiverTypeHTTP,
+							Port: 8080,
+							HTTP: &obs.HTTPReceiver{
+								Format:  obs.HTTPReceiverFormatNDJSON,
+								LogType: obs.InputTypeInfrastructure,
+							},
+						},
+					},
+				},
+
+				Pipelines: []obs.PipelineSpec{
+					{
+						Name: "pipeline1",
+						InputRefs: []string{
+							httpInputName,
+						},
+					},
+				},
+			}
+			ValidatePermissions(internalcontext.ForwarderContext{
+				Client:    k8sAppClient,
+				Reader:    k8sAppClient,
+				Forwarder: &customClf,
+			})
+			Expect(customClf.Status.Conditions).To(HaveCondition(obs.ConditionTypeAuthorized, false, obs.ReasonClusterRoleMissing, "insufficient permissions"))
+		})
+
+		It("should pass validation if service account can collect application logs and there is an events input of application namespaces", func() {
+			k8sAppClient := &mockAppSARClient{
+				fake.NewFakeClient(clfServiceAccount),
+			}
+
+			const eventsInputName = `my-events`
+			customClf.Spec = obs.ClusterLogForwarderSpec{
+				ServiceAccount: obs.ServiceAccount{
+					Name: clfServiceAccount.Name,
+				},
+				Inputs: []obs.InputSpec{
+					{
+						Name:   eventsInputName,
+						Type:   obs.InputTypeKubernetesEvents,
+						Events: &obs.KubernetesEvents{Namespaces: []string{"my-app"}},
+					},
+				},
+
+				Pipelines: []obs.PipelineSpec{
+					{
+						Name: "pipeline1",
+						InputRefs: []string{
+							eventsInputName,
+						},
+					},
+				},
+			}
+			ValidatePermissions(internalcontext.ForwarderContext{
+				Client:    k8sAppClient,
+				Reader:    k8sAppClient,
+				Forwarder: &customClf,
+			})
+			Expect(customClf.Status.Conditions).To(HaveCondition(obs.ConditionTypeAuthorized, true, obs.ReasonClusterRolesExist, ""))
+		})
+
+		It("should fail validation if service account can only collect application logs and there is an events input of all namespaces", func() {
+			k8sAppClient := &mockAppSARClient{
+				fake.NewFakeClient(clfServiceAccount),
+			}
+
+			const eventsInputName = `my-events`
+			customClf.Spec = obs.ClusterLogForwarderSpec{
+				ServiceAccount: obs.ServiceAccount{
+					Name: clfServiceAccount.Name,
+				},
+				Inputs: []obs.InputSpec{
+					{
+						Name:   eventsInputName,
+						Type:   obs.InputTypeKubernetesEvents,
+						Events: &obs.KubernetesEvents{},
+					},
+				},
+
+				Pipelines: []obs.PipelineSpec{
+					{
+						Name: "pipeline1",
+						InputRefs: []string{
+							eventsInputName,
+						},
+					},
+				},
+			}
+			ValidatePermissions(internalcontext.ForwarderContext{
+				Client:    k8sAppClient,
+				Reader:    k8sAppClient,
+				Forwarder: &customClf,
+			})
+			Expect(customClf.Status.Conditions).To(HaveCondition(obs.ConditionTypeAuthorized, false, obs.ReasonClusterRoleMissing, "insufficient permissions"))
+		})
+
 		It("should pass validation if service account can collect external logs and there is a Syslog receiver", func() {
 			const syslogInputName = `syslog-receiver`
 			customClf.Spec = obs.ClusterLogForwarderSpec{
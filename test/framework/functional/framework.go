@@ -432,6 +432,10 @@ func (f *CollectorFunctionalFramework) addOutputContainers(b *runtime.PodBuilder
 			if err := f.AddCloudWatchOutput(b, output); err != nil {
 				return err
 			}
+		case obs.OutputTypeS3:
+			if err := f.AddS3Output(b, output); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
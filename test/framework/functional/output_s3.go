@@ -0,0 +1,61 @@
+package functional
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// minioImage is the S3-compatible object store used as a stand-in for Amazon S3
+	minioImage = "quay.io/minio/minio:latest"
+	S3Secret   = "s3-secret"
+	S3Bucket   = "functional-archive"
+	S3URL      = "http://localhost:9000"
+
+	minioAlias  = "local"
+	minioVolume = "minio-data"
+)
+
+// minioScript starts the object store and creates the bucket to which the collector writes
+var minioScript = fmt.Sprintf(`minio server /data --address :9000 &
+until mc alias set %[1]s %[2]s "$MINIO_ROOT_USER" "$MINIO_ROOT_PASSWORD"; do sleep 1; done
+mc mb --ignore-existing %[1]s/%[3]s
+wait`, minioAlias, S3URL, S3Bucket)
+
+func (f *CollectorFunctionalFramework) AddS3Output(b *runtime.PodBuilder, output obs.OutputSpec) error {
+	log.V(2).Info("Adding minio as the s3 output", "name", output.Name)
+	b.AddContainer(strings.ToLower(output.Name), minioImage).
+		AddEnvVar("MINIO_ROOT_USER", AwsAccessKeyID).
+		AddEnvVar("MINIO_ROOT_PASSWORD", AwsSecretAccessKey).
+		AddEnvVar("HOME", "/tmp").
+		AddVolumeMount(minioVolume, "/data", "", false).
+		WithCmd([]string{"/bin/sh", "-c", minioScript}).
+		End().
+		AddEmptyDirVolume(minioVolume)
+	return nil
+}
+
+// ReadLogsFromS3 reads the records of all the objects written to the bucket under the given key prefix
+func (f *CollectorFunctionalFramework) ReadLogsFromS3(outputName, keyPrefix string) (results []string, err error) {
+	var result string
+	cmd := fmt.Sprintf(`mc find %s/%s/%s --exec "mc cat {}"`, minioAlias, S3Bucket, keyPrefix)
+	err = wait.PollUntilContextTimeout(context.TODO(), defaultRetryInterval, f.GetMaxReadDuration(), true, func(cxt context.Context) (done bool, err error) {
+		result, err = f.RunCommand(outputName, "/bin/sh", "-c", cmd)
+		if result != "" && err == nil {
+			return true, nil
+		}
+		log.V(4).Error(err, "Polling logs from s3")
+		return false, nil
+	})
+	if err == nil {
+		results = strings.Split(strings.TrimSpace(result), "\n")
+	}
+	log.V(4).Info("Returning", "logs", result)
+	return results, err
+}
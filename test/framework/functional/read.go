@@ -127,6 +127,15 @@ func (f *CollectorFunctionalFramework) ReadLogsFrom(outputName, sourceType strin
 			}
 			return f.GetLogsFromElasticSearch(outputName, sourceType, option)
 		}
+	case obs.OutputTypeS3:
+		readLogs = func() ([]string, error) {
+			switch sourceType {
+			case string(obs.InputTypeApplication), string(obs.InputTypeInfrastructure):
+			default:
+				sourceType = string(obs.InputTypeAudit)
+			}
+			return f.ReadLogsFromS3(outputName, sourceType)
+		}
 	default:
 		readLogs = func() ([]string, error) {
 			var result string
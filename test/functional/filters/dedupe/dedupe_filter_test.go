@@ -0,0 +1,55 @@
+package dedupe
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[Functional][Filters][Dedupe] Dedupe filter", func() {
+	var (
+		f *functional.CollectorFunctionalFramework
+	)
+
+	BeforeEach(func() {
+		f = functional.NewCollectorFunctionalFramework()
+	})
+	AfterEach(func() {
+		f.Cleanup()
+	})
+
+	DescribeTable("should drop duplicate records", func(windowMs *int64) {
+		testruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			WithFilter("my-dedupe", func(spec *obs.FilterSpec) {
+				spec.Type = obs.FilterTypeDedupe
+				spec.DedupeFilterSpec = &obs.DedupeFilterSpec{
+					Fields:   []obs.FieldPath{".message", ".kubernetes.pod_name"},
+					WindowMs: windowMs,
+				}
+			}).
+			ToHttpOutput()
+		ExpectOK(f.Deploy())
+
+		duplicate := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "the same message")
+		Expect(f.WriteMessagesToApplicationLog(duplicate, 10)).To(Succeed())
+		other := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "another message")
+		Expect(f.WriteMessagesToApplicationLog(other, 1)).To(Succeed())
+
+		logs, err := f.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		Expect(logs).To(HaveLen(2))
+		Expect(logs[0].Message).To(Equal("the same message"))
+		Expect(logs[1].Message).To(Equal("another message"))
+	},
+		Entry("without a window", nil),
+		Entry("within a window", utils.GetPtr(int64(3600000))),
+	)
+})
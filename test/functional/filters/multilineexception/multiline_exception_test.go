@@ -62,6 +62,10 @@ runtime.goexit()
 	/usr/local/go/src/runtime/asm_amd64.s:2337 +0x1 fp=0xc42003f7e0 sp=0xc42003f7d8 pc=0x44b4d1
 created by main.main
 	foo.go:5 +0x58`
+		customException = `*** ERROR in worker 7
+    stage: load
+    cause: connection refused`
+
 		framework *functional.CollectorFunctionalFramework
 
 		appNamespace = "multi-line-test"
@@ -125,6 +129,30 @@ created by main.main
 				WithMultilineErrorDetectionFilter().
 				ToElasticSearchOutput()
 		}),
+		Entry("of Java services using only the java language", constants.STDERR, javaException, func(framework *functional.CollectorFunctionalFramework) {
+			testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+				FromInput(obs.InputTypeApplication).
+				WithFilter("java-exceptions", func(spec *obs.FilterSpec) {
+					spec.Type = obs.FilterTypeDetectMultiline
+					spec.DetectMultilineExceptionSpec = &obs.DetectMultilineExceptionSpec{
+						Languages: []obs.MultilineExceptionLanguage{obs.MultilineExceptionLanguageJava},
+					}
+				}).
+				ToHttpOutput()
+		}),
+		Entry("of an unsupported runtime using a start pattern", constants.STDOUT, customException, func(framework *functional.CollectorFunctionalFramework) {
+			testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+				FromInput(obs.InputTypeApplication).
+				WithFilter("custom-exceptions", func(spec *obs.FilterSpec) {
+					spec.Type = obs.FilterTypeDetectMultiline
+					spec.DetectMultilineExceptionSpec = &obs.DetectMultilineExceptionSpec{
+						Patterns: &obs.MultilinePatterns{
+							StartPattern: `^\*\*\* `,
+						},
+					}
+				}).
+				ToHttpOutput()
+		}),
 	)
 
 })
@@ -0,0 +1,115 @@
+package parse
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[functional][filters][parse] Log parsing formats", func() {
+	const (
+		timestamp = "2020-11-04T18:13:59.061892+00:00"
+	)
+	var (
+		framework *functional.CollectorFunctionalFramework
+
+		deployWithParseFilter = func(spec obs.ParseFilterSpec) {
+			testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+				FromInput(obs.InputTypeApplication).
+				WithFilter("my-parse", func(f *obs.FilterSpec) {
+					f.Type = obs.FilterTypeParse
+					f.ParseFilterSpec = &spec
+				}).
+				ToHttpOutput()
+			ExpectOK(framework.Deploy())
+		}
+	)
+
+	BeforeEach(func() {
+		framework = functional.NewCollectorFunctionalFramework()
+	})
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	It("should parse a logfmt message into structured and remove the message", func() {
+		deployWithParseFilter(obs.ParseFilterSpec{Format: obs.ParseFormatLogfmt})
+
+		Expect(framework.WriteMessagesToApplicationLog(functional.NewFullCRIOLogMessage(timestamp, `level=info msg="hello world" user=alice`), 1)).To(Succeed())
+
+		logs, err := framework.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		Expect(logs).To(HaveLen(1))
+		Expect(logs[0].Message).To(BeEmpty())
+		Expect(logs[0].Structured).To(Equal(map[string]interface{}{
+			"level": "info",
+			"msg":   "hello world",
+			"user":  "alice",
+		}))
+	})
+
+	It("should parse named captures of a regex into the target field and keep the message", func() {
+		message := "GET /index.html 200"
+		deployWithParseFilter(obs.ParseFilterSpec{
+			Format:       obs.ParseFormatRegex,
+			Target:       ".structured",
+			KeepOriginal: true,
+			Regex: &obs.ParseRegex{
+				Pattern: `^(?P<method>\w+) (?P<path>\S+) (?P<status>\d+)$`,
+			},
+		})
+
+		Expect(framework.WriteMessagesToApplicationLog(functional.NewFullCRIOLogMessage(timestamp, message), 1)).To(Succeed())
+
+		logs, err := framework.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		Expect(logs).To(HaveLen(1))
+		Expect(logs[0].Message).To(Equal(message))
+		Expect(logs[0].Structured).To(Equal(map[string]interface{}{
+			"method": "GET",
+			"path":   "/index.html",
+			"status": "200",
+		}))
+	})
+
+	It("should parse a csv row using the headers", func() {
+		deployWithParseFilter(obs.ParseFilterSpec{
+			Format: obs.ParseFormatCSV,
+			CSV: &obs.ParseCSV{
+				Headers: []string{"level", "component", "msg"},
+			},
+		})
+
+		Expect(framework.WriteMessagesToApplicationLog(functional.NewFullCRIOLogMessage(timestamp, `warn,db,"slow query, 5s"`), 1)).To(Succeed())
+
+		logs, err := framework.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		Expect(logs).To(HaveLen(1))
+		Expect(logs[0].Structured).To(Equal(map[string]interface{}{
+			"level":     "warn",
+			"component": "db",
+			"msg":       "slow query, 5s",
+		}))
+	})
+
+	It("should not modify a message that does not match the format", func() {
+		message := "this is not key value data"
+		deployWithParseFilter(obs.ParseFilterSpec{
+			Format: obs.ParseFormatRegex,
+			Regex: &obs.ParseRegex{
+				Pattern: `^(?P<method>GET|POST) (?P<path>\S+)$`,
+			},
+		})
+
+		Expect(framework.WriteMessagesToApplicationLog(functional.NewFullCRIOLogMessage(timestamp, message), 1)).To(Succeed())
+
+		logs, err := framework.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		Expect(logs).To(HaveLen(1))
+		Expect(logs[0].Message).To(Equal(message))
+		Expect(logs[0].Structured).To(BeNil())
+	})
+})
@@ -0,0 +1,47 @@
+package redact
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[Functional][Filters][Redact] Redact filter", func() {
+	var (
+		f *functional.CollectorFunctionalFramework
+	)
+
+	BeforeEach(func() {
+		f = functional.NewCollectorFunctionalFramework()
+	})
+	AfterEach(func() {
+		f.Cleanup()
+	})
+
+	It("should mask sensitive values of the message", func() {
+		testruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			WithFilter("my-redact", func(spec *obs.FilterSpec) {
+				spec.Type = obs.FilterTypeRedact
+				spec.RedactFilterSpec = &obs.RedactFilterSpec{
+					Patterns:       []obs.RedactPattern{obs.RedactPatternEmail, obs.RedactPatternIPv4},
+					CustomPatterns: []string{`password=\S+`},
+				}
+			}).
+			ToHttpOutput()
+		ExpectOK(f.Deploy())
+
+		msg := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "user jane@example.com from 10.0.0.1 sent password=hunter2")
+		Expect(f.WriteMessagesToApplicationLog(msg, 1)).To(Succeed())
+
+		logs, err := f.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		Expect(logs).To(HaveLen(1))
+		Expect(logs[0].Message).To(Equal("user [REDACTED] from [REDACTED] sent [REDACTED]"))
+	})
+})
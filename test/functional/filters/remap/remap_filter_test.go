@@ -0,0 +1,76 @@
+package remap
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[Functional][Filters][Remap] Remap filter", func() {
+	var (
+		f *functional.CollectorFunctionalFramework
+	)
+
+	BeforeEach(func() {
+		f = functional.NewCollectorFunctionalFramework()
+	})
+	AfterEach(func() {
+		f.Cleanup()
+	})
+
+	It("should apply the user-supplied VRL to each log record", func() {
+		testruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			WithFilter("my-remap", func(spec *obs.FilterSpec) {
+				spec.Type = obs.FilterTypeRemap
+				spec.RemapFilterSpec = &obs.RemapFilterSpec{
+					VRL: `
+.message = upcase(string!(.message))
+.level = "critical"
+`,
+				}
+			}).
+			ToHttpOutput()
+		ExpectOK(f.Deploy())
+
+		msg := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "my remapped message")
+		Expect(f.WriteMessagesToApplicationLog(msg, 1)).To(Succeed())
+
+		logs, err := f.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		Expect(logs).To(HaveLen(1))
+		Expect(logs[0].Message).To(Equal("MY REMAPPED MESSAGE"))
+		Expect(logs[0].Level).To(Equal("critical"))
+	})
+
+	It("should restore the reserved fields changed by the user-supplied VRL", func() {
+		testruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			WithFilter("my-remap", func(spec *obs.FilterSpec) {
+				spec.Type = obs.FilterTypeRemap
+				spec.RemapFilterSpec = &obs.RemapFilterSpec{
+					VRL: `
+. = merge(., {"log_type": "audit", "log_source": "node"})
+. = remove!(., ["kubernetes"])
+`,
+				}
+			}).
+			ToHttpOutput()
+		ExpectOK(f.Deploy())
+
+		msg := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "my remapped message")
+		Expect(f.WriteMessagesToApplicationLog(msg, 1)).To(Succeed())
+
+		logs, err := f.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		Expect(logs).To(HaveLen(1))
+		Expect(logs[0].LogType).To(Equal(string(obs.InputTypeApplication)))
+		Expect(logs[0].LogSource).To(Equal(string(obs.ApplicationSourceContainer)))
+		Expect(logs[0].Kubernetes.NamespaceName).ToNot(BeEmpty())
+	})
+})
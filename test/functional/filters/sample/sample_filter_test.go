@@ -0,0 +1,64 @@
+package sample
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[Functional][Filters][Sample] Sample filter", func() {
+	var (
+		f *functional.CollectorFunctionalFramework
+	)
+
+	BeforeEach(func() {
+		f = functional.NewCollectorFunctionalFramework()
+	})
+	AfterEach(func() {
+		f.Cleanup()
+	})
+
+	It("should forward a sample of the records and all errors", func() {
+		testruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			WithFilter("my-sample", func(spec *obs.FilterSpec) {
+				spec.Type = obs.FilterTypeSample
+				spec.SampleFilterSpec = &obs.SampleFilterSpec{
+					Rate: 10,
+					Exclude: []obs.DropTest{
+						{
+							DropConditions: []obs.DropCondition{
+								{Field: ".message", Matches: "^important"},
+							},
+						},
+					},
+				}
+			}).
+			ToHttpOutput()
+		ExpectOK(f.Deploy())
+
+		info := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "level=info a chatty message")
+		Expect(f.WriteMessagesToApplicationLog(info, 100)).To(Succeed())
+		errs := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "level=error a failure")
+		Expect(f.WriteMessagesToApplicationLog(errs, 5)).To(Succeed())
+		important := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "important message")
+		Expect(f.WriteMessagesToApplicationLog(important, 3)).To(Succeed())
+
+		logs, err := f.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		counts := map[string]int{}
+		for _, log := range logs {
+			counts[log.Message]++
+		}
+		Expect(counts).To(Equal(map[string]int{
+			"level=info a chatty message": 10,
+			"level=error a failure":       5,
+			"important message":           3,
+		}))
+	})
+})
@@ -0,0 +1,184 @@
+package application
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("[Functional][Inputs][Application] Functional tests", func() {
+
+	const (
+		forwardLabel = "logging.example.com/forward"
+		stdoutMsg    = "a message written to stdout"
+		stderrMsg    = "a message written to stderr"
+	)
+
+	var (
+		framework *functional.CollectorFunctionalFramework
+	)
+
+	BeforeEach(func() {
+		framework = functional.NewCollectorFunctionalFramework()
+	})
+
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	writeMessages := func() {
+		now := functional.CRIOTime(time.Now())
+		Expect(framework.WriteMessagesToNamespace(functional.NewCRIOLogMessageWithStream(now, constants.STDOUT, stdoutMsg, false), framework.Namespace, 1)).To(Succeed())
+		Expect(framework.WriteMessagesToNamespace(functional.NewCRIOLogMessageWithStream(now, constants.STDERR, stderrMsg, false), framework.Namespace, 1)).To(Succeed())
+	}
+
+	// readAfterAuditWitness writes an audit log after the application logs and reads the raw logs of the output
+	// once the audit log is forwarded, so application logs which are not forwarded by then are dropped
+	readAfterAuditWitness := func() []string {
+		Expect(framework.WriteK8sAuditLog(1)).To(Succeed())
+		var raw []string
+		Eventually(func() (string, error) {
+			var err error
+			raw, err = framework.ReadRawApplicationLogsFrom(string(obs.OutputTypeHTTP))
+			return strings.Join(raw, "\n"), err
+		}, framework.GetMaxReadDuration(), 5*time.Second).Should(ContainSubstring(`"log_type":"audit"`))
+		return raw
+	}
+
+	Context("when streams are specified", func() {
+		It("should only forward logs from the selected streams", func() {
+			testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+				FromInput(obs.InputTypeApplication, func(spec *obs.InputSpec) {
+					spec.Application.Streams = []obs.ContainerStream{obs.ContainerStreamStderr}
+				}).
+				ToHttpOutput()
+			Expect(framework.Deploy()).To(BeNil())
+
+			writeMessages()
+
+			logs, err := framework.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+			Expect(err).To(BeNil(), "Expected no errors reading the logs")
+			Expect(logs).To(HaveLen(1), "Expected only the stderr message to be forwarded")
+			Expect(logs[0].Message).To(Equal(stderrMsg))
+			Expect(logs[0].Kubernetes.ContainerStream).To(Equal(constants.STDERR))
+		})
+	})
+
+	Context("when a namespace selector is specified", func() {
+		It("should forward logs from namespaces matching the selector", func() {
+			ns := framework.Test.NS
+			if ns.Labels == nil {
+				ns.Labels = map[string]string{}
+			}
+			ns.Labels[forwardLabel] = "true"
+			Expect(framework.Test.Update(ns)).To(Succeed())
+
+			testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+				FromInput(obs.InputTypeApplication, func(spec *obs.InputSpec) {
+					spec.Application.NamespaceSelector = &metav1.LabelSelector{
+						MatchLabels: map[string]string{forwardLabel: "true"},
+					}
+				}).
+				ToHttpOutput()
+			Expect(framework.Deploy()).To(BeNil())
+
+			writeMessages()
+
+			logs, err := framework.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+			Expect(err).To(BeNil(), "Expected no errors reading the logs")
+			Expect(logs).To(HaveLen(2), "Expected logs from the labeled namespace to be forwarded")
+			Expect(logs[0].Kubernetes.NamespaceLabels).To(HaveKeyWithValue(forwardLabel, "true"))
+		})
+	})
+
+	Context("when the pod annotation policy is OptIn", func() {
+		It("should forward logs from pods annotated to opt in", func() {
+			testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+				FromInput(obs.InputTypeApplication, func(spec *obs.InputSpec) {
+					spec.Application.AnnotationPolicy = obs.PodAnnotationPolicyOptIn
+				}).
+				ToHttpOutput()
+			Expect(framework.DeployWithVisitor(func(b *runtime.PodBuilder) error {
+				b.AddAnnotation(constants.AnnotationCollect, "true")
+				return nil
+			})).To(BeNil())
+
+			writeMessages()
+
+			logs, err := framework.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+			Expect(err).To(BeNil(), "Expected no errors reading the logs")
+			Expect(logs).To(HaveLen(2), "Expected logs from the annotated pod to be forwarded")
+		})
+
+		It("should drop logs from pods which are not annotated", func() {
+			testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+				FromInput(obs.InputTypeApplication, func(spec *obs.InputSpec) {
+					spec.Application.AnnotationPolicy = obs.PodAnnotationPolicyOptIn
+				}).
+				AndInput(obs.InputTypeAudit).
+				ToHttpOutput()
+			Expect(framework.Deploy()).To(BeNil())
+
+			writeMessages()
+
+			raw := readAfterAuditWitness()
+			Expect(raw).ToNot(ContainElement(ContainSubstring(stdoutMsg)), "Expected logs from the unannotated pod to be dropped")
+			Expect(raw).ToNot(ContainElement(ContainSubstring(stderrMsg)), "Expected logs from the unannotated pod to be dropped")
+		})
+	})
+
+	Context("when the pod annotation policy is OptOut", func() {
+		It("should drop logs from pods annotated to opt out", func() {
+			testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+				FromInput(obs.InputTypeApplication, func(spec *obs.InputSpec) {
+					spec.Application.AnnotationPolicy = obs.PodAnnotationPolicyOptOut
+				}).
+				AndInput(obs.InputTypeAudit).
+				ToHttpOutput()
+			Expect(framework.DeployWithVisitor(func(b *runtime.PodBuilder) error {
+				b.AddAnnotation(constants.AnnotationCollect, "false")
+				return nil
+			})).To(BeNil())
+
+			writeMessages()
+
+			raw := readAfterAuditWitness()
+			Expect(raw).ToNot(ContainElement(ContainSubstring(stdoutMsg)), "Expected logs from the pod annotated to opt out to be dropped")
+			Expect(raw).ToNot(ContainElement(ContainSubstring(stderrMsg)), "Expected logs from the pod annotated to opt out to be dropped")
+		})
+
+		It("should drop logs from the containers annotated to be excluded", func() {
+			const (
+				excludedContainer = "sidecar"
+				excludedMsg       = "a message written by an excluded container"
+			)
+			testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+				FromInput(obs.InputTypeApplication, func(spec *obs.InputSpec) {
+					spec.Application.AnnotationPolicy = obs.PodAnnotationPolicyOptOut
+				}).
+				ToHttpOutput()
+			Expect(framework.DeployWithVisitor(func(b *runtime.PodBuilder) error {
+				b.AddAnnotation(constants.AnnotationExcludeContainers, "other, "+excludedContainer)
+				return nil
+			})).To(BeNil())
+
+			now := functional.CRIOTime(time.Now())
+			Expect(framework.WriteMessagesToApplicationLogForContainer(functional.NewCRIOLogMessage(now, excludedMsg, false), excludedContainer, 1)).To(Succeed())
+			Expect(framework.WriteMessagesToApplicationLog(functional.NewCRIOLogMessage(now, stdoutMsg, false), 1)).To(Succeed())
+
+			logs, err := framework.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+			Expect(err).To(BeNil(), "Expected no errors reading the logs")
+			Expect(logs).To(HaveLen(1), "Expected only the logs of the containers which are not excluded to be forwarded")
+			Expect(logs[0].Message).To(Equal(stdoutMsg))
+			Expect(logs[0].Kubernetes.ContainerName).To(Equal(constants.CollectorName))
+		})
+	})
+})
@@ -209,4 +209,60 @@ var _ = Describe("[Functional][Inputs][Http] Functional tests", func() {
 			Expect(logs[0]).To(FitLogFormatTemplate(events.Items[1]))
 		})
 	})
+
+	Context("When sending records to an HTTP input which receives generic formats", func() {
+		var (
+			readRecords = func() []map[string]interface{} {
+				raw, err := framework.ReadFileFromWithRetryInterval(string(obs.OutputTypeHTTP), functional.ApplicationLogFile, time.Second)
+				Expect(err).To(BeNil(), "Expected no errors reading the logs")
+				var records []map[string]interface{}
+				for _, line := range strings.Split(strings.TrimSpace(raw), "\n") {
+					record := map[string]interface{}{}
+					Expect(json.Unmarshal([]byte(line), &record)).To(Succeed(), "raw line: %q", line)
+					records = append(records, record)
+				}
+				return records
+			}
+		)
+
+		It("should split the items of a json batch and classify them", func() {
+			framework.Forwarder.Spec.Inputs[0].Receiver.HTTP = &obs.HTTPReceiver{
+				Format:    obs.HTTPReceiverFormatJSON,
+				ItemsPath: ".records",
+				LogSource: "functions",
+			}
+			Expect(framework.DeployWithVisitor(
+				func(b *runtime.PodBuilder) error {
+					return framework.AddVectorHttpOutput(b, framework.Forwarder.Spec.Outputs[0])
+				}),
+			).To(BeNil())
+
+			Expect(framework.WriteToHttpInputWithPortForwarder(httpInputName, []byte(`{"records":[{"message":"first"},{"message":"second"}]}`))).To(Succeed())
+			records := readRecords()
+			Expect(records).To(HaveLen(2))
+			for i, message := range []string{"first", "second"} {
+				Expect(records[i]).To(HaveKeyWithValue("message", message))
+				Expect(records[i]).To(HaveKeyWithValue("log_type", string(obs.InputTypeApplication)))
+				Expect(records[i]).To(HaveKeyWithValue("log_source", "functions"))
+			}
+		})
+
+		It("should receive a record per line of plain text", func() {
+			framework.Forwarder.Spec.Inputs[0].Receiver.HTTP = &obs.HTTPReceiver{
+				Format: obs.HTTPReceiverFormatText,
+			}
+			Expect(framework.DeployWithVisitor(
+				func(b *runtime.PodBuilder) error {
+					return framework.AddVectorHttpOutput(b, framework.Forwarder.Spec.Outputs[0])
+				}),
+			).To(BeNil())
+
+			Expect(framework.WriteToHttpInputWithPortForwarder(httpInputName, []byte("first line\nsecond line\n"))).To(Succeed())
+			records := readRecords()
+			Expect(records).To(HaveLen(2))
+			Expect(records[0]).To(HaveKeyWithValue("message", "first line"))
+			Expect(records[1]).To(HaveKeyWithValue("message", "second line"))
+			Expect(records[1]).To(HaveKeyWithValue("log_source", "http"))
+		})
+	})
 })
@@ -0,0 +1,70 @@
+package azurelogsingestion
+
+import (
+	"strings"
+	"time"
+
+	obstestruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	"github.com/openshift/cluster-logging-operator/test/helpers/azuremonitor"
+	"github.com/openshift/cluster-logging-operator/test/helpers/rand"
+)
+
+const (
+	failedReason = "reason=\"Service call failed. No retries or retries exhausted.\""
+)
+
+var _ = Describe("Forwarding to Azure Logs Ingestion API", func() {
+	var (
+		framework *functional.CollectorFunctionalFramework
+	)
+
+	BeforeEach(func() {
+		framework = functional.NewCollectorFunctionalFramework()
+		secret := runtime.NewSecret(framework.Namespace, azuremonitor.AzureSecretName,
+			map[string][]byte{
+				"tenant_id":     []byte("00000000-0000-0000-0000-000000000000"),
+				"client_id":     []byte("11111111-1111-1111-1111-111111111111"),
+				"client_secret": rand.Word(16),
+			},
+		)
+		framework.Secrets = append(framework.Secrets, secret)
+
+		obstestruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			ToAzureLogsIngestionOutput()
+		Expect(framework.DeployWithVisitor(func(b *runtime.PodBuilder) error {
+			return azuremonitor.NewMockoonVisitorForEnvironment(b, azuremonitor.AzureDomain, azuremonitor.AzureLogsIngestionApiJsonFile, azuremonitor.AzureLogsIngestionApi, framework)
+		})).To(BeNil())
+	})
+
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	It("should authenticate with the client secret and send application logs", func() {
+		timestamp := "2020-11-04T18:13:59.061892+00:00"
+		message := "This is my new test message"
+		applicationLogLine := functional.NewCRIOLogMessage(timestamp, message, false)
+		Expect(framework.WriteMessagesToApplicationLog(applicationLogLine, 3)).To(BeNil())
+		time.Sleep(30 * time.Second)
+
+		collectorLog, err := framework.ReadCollectorLogs()
+		Expect(err).To(BeNil())
+		Expect(strings.Count(collectorLog, failedReason)).To(BeEquivalentTo(0))
+
+		appLogs, err := azuremonitor.ReadApplicationLogFromMockoon(framework)
+		Expect(err).To(BeNil())
+		Expect(appLogs).To(HaveLen(3))
+		for _, appLog := range appLogs {
+			Expect(appLog.Message).To(Equal(message))
+			Expect(appLog.Kubernetes.ContainerName).To(Equal(constants.CollectorName))
+		}
+	})
+})
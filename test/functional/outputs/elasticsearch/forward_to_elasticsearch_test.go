@@ -70,7 +70,7 @@ var _ = Describe("[Functional][Outputs][ElasticSearch] Logforwarding to ElasticS
 
 	DescribeTable("should be compatible with version", func(version functional.ElasticsearchVersion) {
 		var secret *corev1.Secret
-		var auth obs.HTTPAuthentication
+		var auth obs.ElasticsearchAuthentication
 		framework := functional.NewCollectorFunctionalFramework()
 		if version > functional.ElasticsearchVersion7 {
 			secret = runtime.NewSecret(framework.Namespace, "mysecret", map[string][]byte{
@@ -78,14 +78,16 @@ var _ = Describe("[Functional][Outputs][ElasticSearch] Logforwarding to ElasticS
 				constants.ClientPassword: []byte("elasticadmin"),
 			})
 			framework.Secrets = append(framework.Secrets, secret)
-			auth = obs.HTTPAuthentication{
-				Username: &obs.SecretReference{
-					Key:        constants.ClientUsername,
-					SecretName: "mysecret",
-				},
-				Password: &obs.SecretReference{
-					Key:        constants.ClientPassword,
-					SecretName: "mysecret",
+			auth = obs.ElasticsearchAuthentication{
+				HTTPAuthentication: obs.HTTPAuthentication{
+					Username: &obs.SecretReference{
+						Key:        constants.ClientUsername,
+						SecretName: "mysecret",
+					},
+					Password: &obs.SecretReference{
+						Key:        constants.ClientPassword,
+						SecretName: "mysecret",
+					},
 				},
 			}
 		}
@@ -80,14 +80,16 @@ var _ = Describe("[Functional][Outputs][Http] Functional tests", func() {
 			userName := "imauser"
 			password := "iwonttell"
 			secretName := "mysecrets"
-			framework.Forwarder.Spec.Outputs[0].HTTP.Authentication = &obs.HTTPAuthentication{
-				Username: &obs.SecretReference{
-					Key:        "username",
-					SecretName: secretName,
-				},
-				Password: &obs.SecretReference{
-					Key:        "password",
-					SecretName: secretName,
+			framework.Forwarder.Spec.Outputs[0].HTTP.Authentication = &obs.HTTPOutputAuthentication{
+				HTTPAuthentication: obs.HTTPAuthentication{
+					Username: &obs.SecretReference{
+						Key:        "username",
+						SecretName: secretName,
+					},
+					Password: &obs.SecretReference{
+						Key:        "password",
+						SecretName: secretName,
+					},
 				},
 			}
 			framework.Secrets = append(framework.Secrets, runtime.NewSecret(framework.Namespace, secretName, map[string][]byte{
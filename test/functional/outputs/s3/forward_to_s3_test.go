@@ -0,0 +1,89 @@
+package s3
+
+import (
+	"time"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	obstestruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("[Functional][Outputs][S3] Forward Output to S3", func() {
+
+	const (
+		logSize   = 128
+		numOfLogs = 8
+	)
+
+	var (
+		framework *functional.CollectorFunctionalFramework
+		secret    *v1.Secret
+		s3Auth    obs.CloudwatchAuthentication
+	)
+
+	BeforeEach(func() {
+		framework = functional.NewCollectorFunctionalFramework()
+
+		log.V(2).Info("Creating secret s3 with AWS example credentials")
+		secret = runtime.NewSecret(framework.Namespace, functional.S3Secret,
+			map[string][]byte{
+				constants.AWSAccessKeyID:     []byte(functional.AwsAccessKeyID),
+				constants.AWSSecretAccessKey: []byte(functional.AwsSecretAccessKey),
+			},
+		)
+		framework.Secrets = append(framework.Secrets, secret)
+
+		s3Auth = obs.CloudwatchAuthentication{
+			Type: obs.CloudwatchAuthTypeAccessKey,
+			AWSAccessKey: &obs.CloudwatchAWSAccessKey{
+				KeyId: obs.SecretReference{
+					Key:        constants.AWSAccessKeyID,
+					SecretName: functional.S3Secret,
+				},
+				KeySecret: obs.SecretReference{
+					Key:        constants.AWSSecretAccessKey,
+					SecretName: functional.S3Secret,
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	It("should archive application logs as objects under the log type prefix", func() {
+		obstestruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			ToS3Output(s3Auth)
+		Expect(framework.Deploy()).To(BeNil())
+
+		Expect(framework.WritesNApplicationLogsOfSize(numOfLogs, logSize, 0)).To(BeNil())
+		time.Sleep(10 * time.Second)
+
+		logs, err := framework.ReadApplicationLogsFrom(string(obs.OutputTypeS3))
+		Expect(err).To(BeNil())
+		Expect(logs).To(HaveLen(numOfLogs))
+	})
+
+	It("should archive audit logs under a templated key prefix", func() {
+		obstestruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInput(obs.InputTypeAudit).
+			ToS3Output(s3Auth, func(output *obs.OutputSpec) {
+				output.S3.KeyPrefix = `archive/{.log_type||"none"}`
+			})
+		Expect(framework.Deploy()).To(BeNil())
+
+		Expect(framework.WriteAuditHostLog(1)).To(Succeed())
+
+		logs, err := framework.ReadLogsFromS3(string(obs.OutputTypeS3), "archive/audit")
+		Expect(err).To(BeNil())
+		Expect(logs).To(HaveLen(1))
+	})
+})
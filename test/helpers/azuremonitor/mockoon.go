@@ -19,6 +19,9 @@ import (
 //go:embed azure-http-data-collector-api.json
 var AzureHttpDataCollectorApi string
 
+//go:embed azure-logs-ingestion-api.json
+var AzureLogsIngestionApi string
+
 const (
 	Mockoon          = "mockoon"
 	Port             = 3000
@@ -27,6 +30,9 @@ const (
 	data             = "data"
 	AzureDomain      = "acme.com"
 	AzureSecretName  = "azure-secret"
+
+	// AzureLogsIngestionApiJsonFile emulates the Entra ID token and the Logs Ingestion API endpoints without TLS
+	AzureLogsIngestionApiJsonFile = "azure-logs-ingestion-api.json"
 )
 
 type MockoonLog struct {
@@ -74,8 +80,13 @@ type Transaction struct {
 }
 
 func NewMockoonVisitor(pb *runtime.PodBuilder, azureAltHost string, framework *functional.CollectorFunctionalFramework) error {
+	return NewMockoonVisitorForEnvironment(pb, azureAltHost, azureApiJsonFile, AzureHttpDataCollectorApi, framework)
+}
+
+// NewMockoonVisitorForEnvironment adds a mock server to the pod which emulates the APIs defined by the environment file
+func NewMockoonVisitorForEnvironment(pb *runtime.PodBuilder, azureAltHost, environmentFile, environment string, framework *functional.CollectorFunctionalFramework) error {
 	configMap := runtime.NewConfigMap(framework.Namespace, Mockoon, map[string]string{})
-	runtime.NewConfigMapBuilder(configMap).Add(azureApiJsonFile, AzureHttpDataCollectorApi)
+	runtime.NewConfigMapBuilder(configMap).Add(environmentFile, environment)
 	if err := framework.Test.Create(configMap); err != nil {
 		return err
 	}
@@ -91,7 +102,7 @@ func NewMockoonVisitor(pb *runtime.PodBuilder, azureAltHost string, framework *f
 		AddContainer(Mockoon, image).
 		AddContainerPort(Mockoon, Port).
 		WithCmdArgs([]string{
-			fmt.Sprintf("--data=%s/%s", mountPath, azureApiJsonFile),
+			fmt.Sprintf("--data=%s/%s", mountPath, environmentFile),
 			"--log-transaction",
 		}).AddVolumeMount(data, mountPath, "", true).End()
 
@@ -121,7 +132,8 @@ func extractStructuredLogs(output, logType string) ([]types.ApplicationLog, erro
 	// Parse application logs
 	var appLogs []types.ApplicationLog
 	for _, log := range logs {
-		if log.RequestMethod == "POST" && log.ResponseStatus == 200 {
+		// the requests for Entra ID tokens do not contain logs
+		if log.RequestMethod == "POST" && log.ResponseStatus == 200 && !strings.Contains(log.RequestPath, "/oauth2/") {
 			appLog := log.Transaction.Request.Body
 			var tmp []types.ApplicationLog
 			if err := types.ParseLogsFrom(utils.ToJsonLogs([]string{appLog}), &tmp, false); err != nil {
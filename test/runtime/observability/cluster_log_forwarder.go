@@ -179,6 +179,27 @@ func (p *PipelineBuilder) ToCloudwatchOutput(auth obs.CloudwatchAuthentication,
 	return p.ToOutputWithVisitor(v, string(obs.OutputTypeCloudwatch))
 }
 
+func (p *PipelineBuilder) ToS3Output(auth obs.CloudwatchAuthentication, visitors ...func(output *obs.OutputSpec)) *ClusterLogForwarderBuilder {
+	v := func(output *obs.OutputSpec) {
+		output.Name = string(obs.OutputTypeS3)
+		output.Type = obs.OutputTypeS3
+		output.S3 = &obs.S3{
+			URL:            "http://localhost:9000",
+			Region:         "us-east-1",
+			Bucket:         "functional-archive",
+			Authentication: &auth,
+			Tuning: &obs.S3TuningSpec{
+				Compression:  "none",
+				BatchTimeout: 1,
+			},
+		}
+		for _, v := range visitors {
+			v(output)
+		}
+	}
+	return p.ToOutputWithVisitor(v, string(obs.OutputTypeS3))
+}
+
 func (p *PipelineBuilder) ToLokiOutput(lokiURL url.URL, visitors ...func(output *obs.OutputSpec)) *ClusterLogForwarderBuilder {
 	v := func(output *obs.OutputSpec) {
 		output.Name = string(obs.OutputTypeLoki)
@@ -299,6 +320,31 @@ func (p *PipelineBuilder) ToAzureMonitorOutput(visitors ...func(output *obs.Outp
 	return p.ToOutputWithVisitor(v, string(obs.OutputTypeAzureMonitor))
 }
 
+func (p *PipelineBuilder) ToAzureLogsIngestionOutput(visitors ...func(output *obs.OutputSpec)) *ClusterLogForwarderBuilder {
+	v := func(output *obs.OutputSpec) {
+		output.Name = string(obs.OutputTypeAzureLogsIngestion)
+		output.Type = obs.OutputTypeAzureLogsIngestion
+		output.AzureLogsIngestion = &obs.AzureLogsIngestion{
+			URLSpec: obs.URLSpec{
+				URL: "http://localhost:3000",
+			},
+			DCRImmutableID: "dcr-00000000000000000000000000000000",
+			StreamName:     "Custom-MyTable_CL",
+			Authentication: &obs.AzureLogsIngestionAuthentication{
+				Type:          obs.AzureLogsIngestionAuthTypeClientSecret,
+				TenantID:      obs.SecretReference{Key: "tenant_id", SecretName: "azure-secret"},
+				ClientID:      obs.SecretReference{Key: "client_id", SecretName: "azure-secret"},
+				ClientSecret:  &obs.SecretReference{Key: "client_secret", SecretName: "azure-secret"},
+				AuthorityHost: "http://localhost:3000",
+			},
+		}
+		for _, v := range visitors {
+			v(output)
+		}
+	}
+	return p.ToOutputWithVisitor(v, string(obs.OutputTypeAzureLogsIngestion))
+}
+
 func (p *PipelineBuilder) ToOutputWithVisitor(visit OutputSpecVisitor, outputName string) *ClusterLogForwarderBuilder {
 	clf := p.clfb.Forwarder
 	outputs := internalobs.Outputs(clf.Spec.Outputs).Map()